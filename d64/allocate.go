@@ -0,0 +1,164 @@
+package d64
+
+import (
+	"math/big"
+	"math/bits"
+	"slices"
+)
+
+// Allocate splits total into parts in proportion to ratios, each a multiple
+// of unit, such as 0.01 for cents. It uses [DefaultContext].
+// See [Context.Allocate].
+func Allocate(total Decimal, ratios []Decimal, unit Decimal) []Decimal {
+	return DefaultContext.Allocate(total, ratios, unit)
+}
+
+// AllocateEven splits total into n parts that are as nearly equal as
+// possible, each a multiple of unit. It uses [DefaultContext].
+// See [Context.AllocateEven].
+func AllocateEven(total Decimal, n int, unit Decimal) []Decimal {
+	return DefaultContext.AllocateEven(total, n, unit)
+}
+
+// AllocateEven splits total into n parts that are as nearly equal as
+// possible, each a multiple of unit, as for [Context.Allocate] with equal
+// ratios. Earlier parts get any extra units, so splitting 100.00 three ways
+// gives 33.34, 33.33 and 33.33.
+func (ctx Context) AllocateEven(total Decimal, n int, unit Decimal) []Decimal {
+	ratios := make([]Decimal, max(n, 0))
+	for i := range ratios {
+		ratios[i] = One
+	}
+	return ctx.Allocate(total, ratios, unit)
+}
+
+// Allocate splits total into parts in proportion to ratios, each a multiple
+// of unit, such as 0.01 for cents. It uses the largest remainder method:
+// each part gets the whole number of units of its exact share, and the
+// units left over go one each to the parts with the largest remainders,
+// earlier parts first among equal remainders.
+//
+// The parts sum exactly to total rounded with ctx to a multiple of unit,
+// provided that has at most 16 significant digits when written with as many
+// decimal places as unit, as amounts of money do.
+//
+// All parts are NaN if total, unit or any ratio is NaN or ±∞, if unit isn't
+// positive, if any ratio is negative, if the ratios sum to zero or if the
+// total has 2⁶⁴ or more units.
+func (ctx Context) Allocate(total Decimal, ratios []Decimal, unit Decimal) []Decimal {
+	parts := make([]Decimal, len(ratios))
+	if len(ratios) == 0 {
+		return parts
+	}
+	fail := func() []Decimal {
+		for i := range parts {
+			parts[i] = QNaN
+		}
+		return parts
+	}
+
+	neg, count, ok := ctx.units(total, unit)
+	if !ok {
+		return fail()
+	}
+	weights, sum, ok := weights(ratios)
+	if !ok {
+		return fail()
+	}
+
+	// Each part's exact share is count × weight / sum units.
+	n := new(big.Int).SetUint64(count)
+	shares := make([]uint64, len(ratios))
+	rems := make([]*big.Int, len(ratios))
+	left := count
+	var q big.Int
+	for i, w := range weights {
+		rems[i] = new(big.Int)
+		q.QuoRem(q.Mul(n, w), sum, rems[i])
+		shares[i] = q.Uint64()
+		left -= shares[i]
+	}
+
+	// left < len(ratios), since every remainder is less than one unit.
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		return rems[j].Cmp(rems[i])
+	})
+	for _, i := range order[:left] {
+		shares[i]++
+	}
+
+	_, _, coefficient, exp := unit.Decompose(nil)
+	c := new(big.Int).SetBytes(coefficient).Uint64()
+	for i, share := range shares {
+		var sign int8
+		if neg && share != 0 {
+			sign = 1
+		}
+		hi, lo := bits.Mul64(share, c)
+		parts[i], _ = ctx.newFromCoefficient(sign, int(exp), uint128T{lo: lo, hi: hi}, false)
+	}
+	return parts
+}
+
+// units returns the number of units in total, rounded with ctx, and whether
+// total is negative. It reports false if either isn't finite, unit isn't
+// positive or the number doesn't fit in a uint64.
+func (ctx Context) units(total, unit Decimal) (neg bool, count uint64, ok bool) {
+	if !total.isFinite() || !unit.isFinite() || unit.Sign() <= 0 {
+		return false, 0, false
+	}
+	_, neg, tc, te := total.Decompose(nil)
+	_, _, uc, ue := unit.Decompose(nil)
+	e := min(te, ue)
+	t := scaledInt(tc, te-e)
+	u := scaledInt(uc, ue-e)
+
+	var q, r big.Int
+	q.QuoRem(t, u, &r)
+	r.Lsh(&r, 1)
+	switch half := r.Cmp(u); {
+	case ctx.Rounding == Down:
+	case half > 0, half == 0 && (ctx.Rounding == HalfUp || q.Bit(0) == 1):
+		q.Add(&q, big.NewInt(1))
+	}
+	if !q.IsUint64() {
+		return false, 0, false
+	}
+	return neg, q.Uint64(), true
+}
+
+// weights returns ratios as integers with the same scale and their sum. It
+// reports false unless every ratio is finite and nonnegative and the sum is
+// positive.
+func weights(ratios []Decimal) (ws []*big.Int, sum *big.Int, ok bool) {
+	e := int32(0)
+	for i, r := range ratios {
+		if !r.isFinite() || r.Signbit() && !r.IsZero() {
+			return nil, nil, false
+		}
+		if _, _, _, exp := r.Decompose(nil); i == 0 || exp < e {
+			e = exp
+		}
+	}
+	ws = make([]*big.Int, len(ratios))
+	sum = new(big.Int)
+	for i, r := range ratios {
+		_, _, c, exp := r.Decompose(nil)
+		ws[i] = scaledInt(c, exp-e)
+		sum.Add(sum, ws[i])
+	}
+	return ws, sum, sum.Sign() > 0
+}
+
+// scaledInt returns the big-endian coefficient c times 10^n, n ≥ 0.
+func scaledInt(c []byte, n int32) *big.Int {
+	x := new(big.Int).SetBytes(c)
+	if n > 0 {
+		x.Mul(x, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil))
+	}
+	return x
+}
@@ -0,0 +1,121 @@
+package d64
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func parseAll(s string) []Decimal {
+	var ds []Decimal
+	for _, f := range strings.Fields(s) {
+		ds = append(ds, MustParse(f))
+	}
+	return ds
+}
+
+func formatAll(ds []Decimal) string {
+	var b strings.Builder
+	for i, d := range ds {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(d.String())
+	}
+	return b.String()
+}
+
+func TestAllocate(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected, total, ratios, unit string) {
+		t.Helper()
+		parts := Allocate(MustParse(total), parseAll(ratios), MustParse(unit))
+		equal(t, expected, formatAll(parts))
+	}
+	test("33.34 33.33 33.33", "100", "1 1 1", "0.01")
+	test("50 30 20", "100", "5 3 2", "0.01")
+	test("0.34 0.33 0.33", "1", "1 1 1", "0.01")
+	test("0.03 0.02 0.02", "0.07", "1 1 1", "0.01")
+	test("0.67 0.33", "1", "2 1", "0.01")
+	test("33 34 33", "100", "0.333 0.334 0.333", "1")
+	test("0.05 0.05 0", "0.1", "1 1 0", "0.05")
+	test("-33.34 -33.33 -33.33", "-100", "1 1 1", "0.01")
+	test("0 0 0", "0", "1 2 3", "0.01")
+	test("0.01 0 0", "0.01", "1 1 1", "0.01")
+	test("-0.01 0 0", "-0.01", "1 1 1", "0.01")
+	test("100", "100", "7", "0.01")
+	test("1e+20 1e+20", "2e20", "1 1", "1e20")
+	test("", "100", "", "0.01")
+
+	// Largest remainders win, then earlier parts.
+	test("0.01 0.01 0.02", "0.04", "1 1 1.1", "0.01")
+	test("0.02 0.01 0.01", "0.04", "1 1 1", "0.01")
+
+	// Widely different ratio exponents.
+	test("99.99 0.01", "100", "1e300 1e296", "0.01")
+
+	// The total is rounded to the unit first.
+	test("0.51 0.5", "1.005", "1 1", "0.01")
+	test("0.503 0.502", "1.005", "1 1", "0.001")
+
+	for _, s := range [][3]string{
+		{"NaN", "1", "0.01"}, {"inf", "1", "0.01"}, {"1", "NaN", "0.01"}, {"1", "inf", "0.01"},
+		{"1", "1", "NaN"}, {"1", "1", "0"}, {"1", "1", "-0.01"}, {"1", "-1 2", "0.01"},
+		{"1", "0 0", "0.01"}, {"1e30", "1", "0.01"},
+	} {
+		for _, d := range Allocate(MustParse(s[0]), parseAll(s[1]), MustParse(s[2])) {
+			check(t, d.IsNaN()).Or(func() {
+				t.Errorf("expected NaN for %q", s)
+			})
+		}
+	}
+}
+
+func TestAllocateRounding(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, ctx Context, total string) {
+		t.Helper()
+		equal(t, expected, formatAll(ctx.Allocate(MustParse(total), parseAll("1 1"), MustParse("0.01"))))
+	}
+	test("0.51 0.5", Context{Rounding: HalfUp}, "1.005")
+	test("0.5 0.5", Context{Rounding: HalfEven}, "1.005")
+	test("0.51 0.51", Context{Rounding: HalfEven}, "1.015")
+	test("0.51 0.5", Context{Rounding: Down}, "1.019")
+	test("-0.51 -0.5", Context{Rounding: HalfUp}, "-1.005")
+}
+
+func TestAllocateEven(t *testing.T) {
+	t.Parallel()
+
+	equal(t, "33.34 33.33 33.33", formatAll(AllocateEven(MustParse("100"), 3, MustParse("0.01"))))
+	equal(t, "2 2 2 1 1 1 1", formatAll(AllocateEven(MustParse("10"), 7, One)))
+	equal(t, 0, len(AllocateEven(One, 0, One)))
+	equal(t, 0, len(AllocateEven(One, -1, One)))
+}
+
+func TestAllocateSums(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	cent := MustParse("0.01")
+	for i := 0; i < 1000; i++ {
+		total := NewFromInt64(rng.Int63n(1e12) - 5e11).Mul(cent)
+		ratios := make([]Decimal, 1+rng.Intn(10))
+		for j := range ratios {
+			ratios[j] = NewFromInt64(rng.Int63n(1000)).ScaleBInt(-rng.Intn(4))
+		}
+		ratios[0] = ratios[0].Add(One)
+		parts := Allocate(total, ratios, cent)
+
+		sum := Zero
+		for _, p := range parts {
+			sum = sum.Add(p)
+			equal(t, p, p.Round(cent))
+		}
+		check(t, sum.Equal(total)).Or(func() {
+			t.Errorf("%v split %v gives %v, which sums to %v", total, ratios, parts, sum)
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package d64
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultCurrencies are the currency symbols and codes an [AmountParser]
+// accepts if its Currencies field is nil.
+var DefaultCurrencies = []string{
+	"$", "A$", "AU$", "NZ$", "US$", "C$", "S$", "HK$", "€", "£", "¥", "₹",
+	"AUD", "NZD", "USD", "CAD", "SGD", "HKD", "EUR", "GBP", "JPY", "CNY", "INR", "CHF",
+}
+
+// StripKind classifies text removed by [AmountParser.Parse].
+type StripKind int8
+
+const (
+	// StripSpace is whitespace around the number, sign or currency.
+	StripSpace StripKind = iota
+
+	// StripSign is a leading or trailing sign.
+	StripSign
+
+	// StripParens is one of the parentheses around an accounting-style
+	// negative amount.
+	StripParens
+
+	// StripCurrency is a currency symbol or code.
+	StripCurrency
+
+	// StripGroupSep is a grouping separator.
+	StripGroupSep
+)
+
+func (k StripKind) String() string {
+	switch k {
+	case StripSpace:
+		return "StripSpace"
+	case StripSign:
+		return "StripSign"
+	case StripParens:
+		return "StripParens"
+	case StripCurrency:
+		return "StripCurrency"
+	case StripGroupSep:
+		return "StripGroupSep"
+	default:
+		return fmt.Sprintf("Unknown strip kind %d", k)
+	}
+}
+
+// Strip records text removed by [AmountParser.Parse].
+type Strip struct {
+	Kind   StripKind
+	Offset int    // Byte offset of Text in the input
+	Text   string // The text removed
+}
+
+// ParsedAmount is the result of [AmountParser.Parse].
+type ParsedAmount struct {
+	Value    Decimal
+	Currency string  // The currency symbol or code, if any, as written
+	Stripped []Strip // Everything removed from the input, in order
+}
+
+// AmountParser parses amounts entered by people, such as "$1,234.56",
+// "(1.234,56 EUR)" or "12,34,567.89-".
+//
+// It accepts surrounding whitespace, one currency symbol or code before or
+// after the number, and one negative marker: a leading or trailing minus
+// sign, or parentheses around the whole amount. The number itself may have
+// grouping separators, which must be in the positions Format calls for, and
+// Format's decimal separator. It may not have an exponent.
+type AmountParser struct {
+	// Format supplies the decimal separator, grouping separator and group
+	// sizes. Numbers may only be grouped if Format.GroupSize is positive.
+	// A space is accepted in place of a no-break space separator.
+	Format NumberFormat
+
+	// Currencies are the currency symbols and codes to accept. If it is
+	// nil, DefaultCurrencies is used.
+	Currencies []string
+
+	// Options restricts the number after separators and signs are handled,
+	// for example to reject amounts that would be rounded. NoSign rejects
+	// negative amounts.
+	Options ParseOptions
+}
+
+// Parse parses s as an amount. Errors are reported as a [*ParseError].
+func (p AmountParser) Parse(s string) (ParsedAmount, error) {
+	var a ParsedAmount
+	fail := func(offset int, reason string) (ParsedAmount, error) {
+		return ParsedAmount{Value: QNaN}, &ParseError{
+			Func: "AmountParser.Parse", Input: s, Offset: offset, Reason: reason, Err: ErrSyntax,
+		}
+	}
+	unexpected := func(offset int) (ParsedAmount, error) {
+		r, _ := utf8.DecodeRuneInString(s[offset:])
+		return fail(offset, fmt.Sprintf("unexpected %q", r))
+	}
+	strip := func(kind StripKind, start, end int) {
+		a.Stripped = append(a.Stripped, Strip{kind, start, s[start:end]})
+	}
+
+	i, end := 0, len(s)
+	if n := spaceLen(s); n > 0 {
+		strip(StripSpace, 0, n)
+		i = n
+	}
+	trailing := end
+	for trailing > i {
+		r, size := utf8.DecodeLastRuneInString(s[:trailing])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		trailing -= size
+	}
+	end = trailing
+
+	neg, parens := false, false
+	if end-i >= 2 && s[i] == '(' && s[end-1] == ')' {
+		strip(StripParens, i, i+1)
+		i++
+		end--
+		neg, parens = true, true
+	}
+	signed := parens
+
+	// Prefix: sign, currency and spaces in any order.
+	for i < end && !isDigit(rune(s[i])) && !strings.HasPrefix(s[i:end], p.decimalSep()) {
+		if n := signLen(s[i:end]); n > 0 && !signed {
+			strip(StripSign, i, i+n)
+			neg, signed = s[i] != '+', true
+			i += n
+		} else if n := p.currencyLen(s[i:end]); n > 0 && a.Currency == "" {
+			a.Currency = s[i : i+n]
+			strip(StripCurrency, i, i+n)
+			i += n
+		} else if n := spaceLen(s[i:end]); n > 0 {
+			strip(StripSpace, i, i+n)
+			i += n
+		} else {
+			return unexpected(i)
+		}
+	}
+
+	// Number.
+	var buf [40]byte
+	num := buf[:0]
+	groups := 0                            // Grouping separators seen
+	firstGroup, group, intGroup := 0, 0, 0 // Digits in groups
+	inFrac, digits := false, 0             // Decimal separator seen; digits seen
+	start := i
+	for i < end {
+		c := s[i]
+		if isDigit(rune(c)) {
+			num = append(num, c)
+			digits++
+			group++
+			i++
+			continue
+		}
+		if !inFrac && strings.HasPrefix(s[i:end], p.decimalSep()) {
+			num = append(num, '.')
+			inFrac, intGroup = true, group
+			i += len(p.decimalSep())
+			continue
+		}
+		if n := p.groupSepLen(s[i:end]); n > 0 && !inFrac && group > 0 &&
+			i+n < end && isDigit(rune(s[i+n])) {
+			switch {
+			case groups == 0:
+				firstGroup = group
+			case group != p.secondaryGroupSize():
+				return fail(i, "misplaced grouping separator")
+			}
+			groups++
+			group = 0
+			strip(StripGroupSep, i, i+n)
+			i += n
+			continue
+		}
+		break
+	}
+	if digits == 0 {
+		return fail(start, "digits missing")
+	}
+	if !inFrac {
+		intGroup = group
+	}
+	if groups > 0 {
+		if firstGroup > p.secondaryGroupSize() || intGroup != p.Format.GroupSize {
+			return fail(start, "misplaced grouping separator")
+		}
+	}
+
+	// Suffix: spaces, currency and a trailing minus in any order.
+	for i < end {
+		if n := signLen(s[i:end]); n > 0 && !signed && s[i] != '+' {
+			strip(StripSign, i, i+n)
+			neg, signed = true, true
+			i += n
+		} else if n := p.currencyLen(s[i:end]); n > 0 && a.Currency == "" {
+			a.Currency = s[i : i+n]
+			strip(StripCurrency, i, i+n)
+			i += n
+		} else if n := spaceLen(s[i:end]); n > 0 {
+			strip(StripSpace, i, i+n)
+			i += n
+		} else {
+			return unexpected(i)
+		}
+	}
+	if parens {
+		strip(StripParens, end, end+1)
+	}
+	if trailing < len(s) {
+		strip(StripSpace, trailing, len(s))
+	}
+
+	if neg && p.Options.NoSign {
+		return fail(0, "negative amount not allowed")
+	}
+	o := p.Options
+	o.NoSign, o.NoExponent = false, false
+	d, err := o.ParseBytes(num)
+	if err != nil {
+		pe := err.(*ParseError)
+		return ParsedAmount{Value: QNaN}, &ParseError{
+			Func: "AmountParser.Parse", Input: s, Offset: start, Reason: pe.Reason, Err: pe.Err,
+		}
+	}
+	if neg {
+		d = d.Neg()
+	}
+	a.Value = d
+	return a, nil
+}
+
+func (p AmountParser) decimalSep() string {
+	if p.Format.DecimalSep == "" {
+		return "."
+	}
+	return p.Format.DecimalSep
+}
+
+func (p AmountParser) secondaryGroupSize() int {
+	if p.Format.SecondaryGroupSize > 0 {
+		return p.Format.SecondaryGroupSize
+	}
+	return p.Format.GroupSize
+}
+
+// groupSepLen returns the length of the grouping separator at the start of
+// s, or 0 if there isn't one.
+func (p AmountParser) groupSepLen(s string) int {
+	sep := p.Format.GroupSep
+	switch {
+	case sep == "" || p.Format.GroupSize <= 0:
+		return 0
+	case strings.HasPrefix(s, sep):
+		return len(sep)
+	case (sep == "\u00a0" || sep == "\u202f") && strings.HasPrefix(s, " "):
+		return 1
+	}
+	return 0
+}
+
+// currencyLen returns the length of the longest currency symbol or code at
+// the start of s, or 0 if there isn't one.
+func (p AmountParser) currencyLen(s string) int {
+	currencies := p.Currencies
+	if currencies == nil {
+		currencies = DefaultCurrencies
+	}
+	n := 0
+	for _, c := range currencies {
+		if len(c) > n && strings.HasPrefix(s, c) {
+			n = len(c)
+		}
+	}
+	return n
+}
+
+// signLen returns the length of the plus or minus sign at the start of s,
+// or 0 if there isn't one.
+func signLen(s string) int {
+	switch {
+	case strings.HasPrefix(s, "-"), strings.HasPrefix(s, "+"):
+		return 1
+	case strings.HasPrefix(s, "\u2212"):
+		return len("\u2212")
+	}
+	return 0
+}
+
+// spaceLen returns the length of the whitespace at the start of s.
+func spaceLen(s string) int {
+	n := 0
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		n += size
+	}
+	return n
+}
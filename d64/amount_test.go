@@ -0,0 +1,126 @@
+package d64
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestAmountParser(t *testing.T) {
+	t.Parallel()
+
+	en, _ := LocaleFormat("en-AU")
+	de, _ := LocaleFormat("de")
+	in, _ := LocaleFormat("en-IN")
+	fr, _ := LocaleFormat("fr")
+
+	valid := func(p AmountParser, expected, currency, input string) {
+		t.Helper()
+		a, err := p.Parse(input)
+		isnil(t, err)
+		equalD64(t, MustParse(expected), a.Value)
+		equal(t, currency, a.Currency)
+	}
+	invalid := func(p AmountParser, offset int, input string) {
+		t.Helper()
+		_, err := p.Parse(input)
+		var pe *ParseError
+		if check(t, errors.As(err, &pe)) {
+			equal(t, offset, pe.Offset)
+			check(t, errors.Is(err, ErrSyntax))
+		}
+	}
+
+	p := AmountParser{Format: en}
+	valid(p, "1234.56", "$", "$1,234.56")
+	valid(p, "1234.56", "A$", " A$ 1,234.56 ")
+	valid(p, "-1234.56", "$", "-$1,234.56")
+	valid(p, "-1234.56", "$", "$-1,234.56")
+	valid(p, "-1234.56", "AUD", "(AUD 1,234.56)")
+	valid(p, "-1234.56", "$", "($1,234.56)")
+	valid(p, "-1234.56", "AUD", "1,234.56- AUD")
+	valid(p, "-1234.56", "AUD", "1,234.56 AUD-")
+	valid(p, "1234567", "", "1,234,567")
+	valid(p, "1234567.5", "", "1234567.5")
+	valid(p, "0.5", "", ".5")
+	valid(p, "5", "", "+5.")
+	valid(p, "-12", "", "−12")
+	valid(p, "999", "", "999")
+
+	valid(AmountParser{Format: de}, "1234567.89", "€", "1.234.567,89 €")
+	valid(AmountParser{Format: de}, "-1.5", "EUR", "-1,5 EUR")
+	valid(AmountParser{Format: in}, "1234567.89", "₹", "₹12,34,567.89")
+	valid(AmountParser{Format: in}, "12345", "", "12,345")
+	valid(AmountParser{Format: fr}, "1234567.89", "€", "1\u202f234\u202f567,89 €")
+	valid(AmountParser{Format: fr}, "1234567.89", "€", "1 234 567,89 €")
+	valid(AmountParser{Format: fr}, "1234.5", "", "1234,5")
+	valid(AmountParser{Currencies: []string{"BTC"}}, "0.1", "BTC", "BTC0.1")
+
+	invalid(p, 0, "")
+	invalid(p, 1, "$")
+	invalid(p, 0, "1,23")
+	invalid(p, 5, "12,34,567")
+	invalid(p, 0, "1234,567")
+	invalid(p, 7, "1,234.5,6")
+	invalid(p, 1, "-(1)")
+	invalid(p, 1, "(-1)")
+	invalid(p, 1, "--1")
+	invalid(p, 3, "$1 $")
+	invalid(p, 1, "1+")
+	invalid(p, 1, "1e3")
+	invalid(p, 0, "¤1")
+	invalid(p, 1, "1,")
+	invalid(AmountParser{Format: in}, 0, "123,456")
+	invalid(AmountParser{Format: in}, 5, "1,234,567")
+	invalid(AmountParser{Format: de}, 5, "1,234.56")
+	invalid(AmountParser{}, 1, "1,234")
+	invalid(AmountParser{Currencies: []string{}}, 0, "$1")
+}
+
+func TestAmountParserStripped(t *testing.T) {
+	t.Parallel()
+
+	en, _ := LocaleFormat("en")
+	a, err := AmountParser{Format: en}.Parse(" ( $1,234,567.00 ) ")
+	isnil(t, err)
+	equalD64(t, MustParse("-1234567.00"), a.Value)
+	equal(t, fmt.Sprint([]Strip{
+		{StripSpace, 0, " "},
+		{StripParens, 1, "("},
+		{StripSpace, 2, " "},
+		{StripCurrency, 3, "$"},
+		{StripGroupSep, 5, ","},
+		{StripGroupSep, 9, ","},
+		{StripSpace, 16, " "},
+		{StripParens, 17, ")"},
+		{StripSpace, 18, " "},
+	}), fmt.Sprint(a.Stripped))
+
+	a, err = AmountParser{Format: en}.Parse("12-")
+	isnil(t, err)
+	equal(t, fmt.Sprint([]Strip{{StripSign, 2, "-"}}), fmt.Sprint(a.Stripped))
+
+	equal(t, "StripGroupSep", StripGroupSep.String())
+	equal(t, "Unknown strip kind 9", StripKind(9).String())
+}
+
+func TestAmountParserOptions(t *testing.T) {
+	t.Parallel()
+
+	p := AmountParser{Options: ParseOptions{Exact: true, NoSign: true}}
+	a, err := p.Parse("$12.345")
+	isnil(t, err)
+	equalD64(t, MustParse("12.345"), a.Value)
+
+	_, err = p.Parse("$1.00000000000000001")
+	check(t, errors.Is(err, ErrInexact))
+	var pe *ParseError
+	if check(t, errors.As(err, &pe)) {
+		equal(t, "AmountParser.Parse", pe.Func)
+		equal(t, "$1.00000000000000001", pe.Input)
+		equal(t, 1, pe.Offset)
+	}
+
+	_, err = p.Parse("(1)")
+	check(t, errors.Is(err, ErrSyntax))
+}
@@ -0,0 +1,289 @@
+package d64
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// CBOR major types, tags and half-precision floats, per RFC 8949.
+const (
+	cborUint   = 0
+	cborNegInt = 1
+	cborBytes  = 2
+	cborArray  = 4
+	cborTag    = 6
+	cborSimple = 7
+
+	cborFloat16 = 25
+	cborFloat32 = 26
+	cborFloat64 = 27
+
+	cborPosBignum = 2
+	cborNegBignum = 3
+	cborDecFrac   = 4
+
+	cborQNaN16    = 0x7e00
+	cborSNaN16    = 0x7d00
+	cborInf16     = 0x7c00
+	cborNegZero16 = 0x8000
+
+	// cborMaxExp bounds decoded exponents, well beyond any that matters.
+	cborMaxExp = 1 << 20
+)
+
+var errCBOREOF = errors.New("cbor: unexpected end of input")
+
+// AppendCBOR appends d to buf as a CBOR data item.
+//
+// Finite numbers are encoded as a decimal fraction (tag 4) holding the array
+// [exponent, mantissa], with trailing zeros removed from the mantissa, so
+// 12.50 encodes as 4([-1, 125]). NaN, ±∞ and -0, which decimal fractions
+// can't represent, fall back to half-precision floats.
+func (d Decimal) AppendCBOR(buf []byte) []byte {
+	var dp decParts
+	dp.unpack(d)
+	switch {
+	case dp.fl == flQNaN:
+		return appendCBORHead(buf, cborSimple, cborFloat16, cborQNaN16)
+	case dp.fl == flSNaN:
+		return appendCBORHead(buf, cborSimple, cborFloat16, cborSNaN16)
+	case dp.fl == flInf:
+		return appendCBORHead(buf, cborSimple, cborFloat16, uint64(dp.sign)<<15|cborInf16)
+	case dp.isZero() && dp.sign == 1:
+		return appendCBORHead(buf, cborSimple, cborFloat16, cborNegZero16)
+	}
+
+	dp.trimZeros()
+	buf = appendCBORUint(buf, cborTag, cborDecFrac)
+	buf = appendCBORUint(buf, cborArray, 2)
+	if dp.exp < 0 {
+		buf = appendCBORUint(buf, cborNegInt, uint64(-dp.exp-1))
+	} else {
+		buf = appendCBORUint(buf, cborUint, uint64(dp.exp))
+	}
+	if dp.sign == 1 {
+		return appendCBORUint(buf, cborNegInt, dp.significand.lo-1)
+	}
+	return appendCBORUint(buf, cborUint, dp.significand.lo)
+}
+
+// appendCBORUint appends a data item head with the shortest encoding of n.
+func appendCBORUint(buf []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(buf, major<<5|byte(n))
+	case n <= math.MaxUint8:
+		return appendCBORHead(buf, major, 24, n)
+	case n <= math.MaxUint16:
+		return appendCBORHead(buf, major, 25, n)
+	case n <= math.MaxUint32:
+		return appendCBORHead(buf, major, 26, n)
+	default:
+		return appendCBORHead(buf, major, 27, n)
+	}
+}
+
+// appendCBORHead appends a data item head with additional information info,
+// which must be 24 to 27, followed by n in 1 to 8 bytes.
+func appendCBORHead(buf []byte, major, info byte, n uint64) []byte {
+	buf = append(buf, major<<5|info)
+	switch info {
+	case 24:
+		return append(buf, byte(n))
+	case 25:
+		return binary.BigEndian.AppendUint16(buf, uint16(n))
+	case 26:
+		return binary.BigEndian.AppendUint32(buf, uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(buf, n)
+	}
+}
+
+// cborHead is the head of a CBOR data item.
+type cborHead struct {
+	major, info byte
+	arg         uint64
+	size        int
+}
+
+// readCBORHead reads the head of the data item at the start of b.
+func readCBORHead(b []byte) (cborHead, error) {
+	if len(b) == 0 {
+		return cborHead{}, errCBOREOF
+	}
+	h := cborHead{major: b[0] >> 5, info: b[0] & 0x1f}
+	switch {
+	case h.info < 24:
+		h.arg, h.size = uint64(h.info), 1
+	case h.info <= 27:
+		h.size = 1 + 1<<(h.info-24)
+		if len(b) < h.size {
+			return cborHead{}, errCBOREOF
+		}
+		for _, x := range b[1:h.size] {
+			h.arg = h.arg<<8 | uint64(x)
+		}
+	default:
+		return cborHead{}, fmt.Errorf("cbor: unsupported additional information %d", h.info)
+	}
+	return h, nil
+}
+
+// DecodeCBOR decodes the CBOR data item at the start of b into d and returns
+// its length in bytes. It uses [DefaultScanContext].
+// See [Context.DecodeCBOR].
+func (d *Decimal) DecodeCBOR(b []byte) (int, error) {
+	return DefaultScanContext.DecodeCBOR(d, b)
+}
+
+// DecodeCBOR decodes the CBOR data item at the start of b into d and returns
+// its length in bytes.
+//
+// It accepts decimal fractions (tag 4) with an integer or bignum mantissa,
+// as well as plain integers, bignums (tags 2 and 3) and floats.
+// Values with more than 16 significant digits are rounded according to ctx,
+// and values too large for a [Decimal] become ±∞. In both cases d is set and
+// [ErrInexact] or [ErrRange] is returned along with the length. For any
+// other error, d is left unchanged and the length is zero.
+func (ctx Context) DecodeCBOR(d *Decimal, b []byte) (int, error) {
+	h, err := readCBORHead(b)
+	if err != nil {
+		return 0, err
+	}
+	if h.major != cborTag || h.arg != cborDecFrac {
+		return ctx.decodeCBORNumber(d, b, h, 0)
+	}
+
+	size := h.size
+	h, err = readCBORHead(b[size:])
+	if err != nil {
+		return 0, err
+	}
+	if h.major != cborArray || h.arg != 2 {
+		return 0, errors.New("cbor: decimal fraction must be a two-element array")
+	}
+	size += h.size
+
+	h, err = readCBORHead(b[size:])
+	if err != nil {
+		return 0, err
+	}
+	var exp int
+	switch h.major {
+	case cborUint:
+		exp = int(min(h.arg, cborMaxExp))
+	case cborNegInt:
+		exp = -1 - int(min(h.arg, cborMaxExp))
+	default:
+		return 0, errors.New("cbor: decimal fraction exponent must be an integer")
+	}
+	size += h.size
+
+	h, err = readCBORHead(b[size:])
+	if err != nil {
+		return 0, err
+	}
+	if h.major == cborSimple {
+		return 0, errors.New("cbor: decimal fraction mantissa must be an integer")
+	}
+	n, err := ctx.decodeCBORNumber(d, b[size:], h, exp)
+	if n == 0 {
+		return 0, err
+	}
+	return size + n, err
+}
+
+// decodeCBORNumber decodes the integer, bignum or float at the start of b,
+// whose head h has already been read, and stores it in d multiplied by
+// 10^exp.
+func (ctx Context) decodeCBORNumber(d *Decimal, b []byte, h cborHead, exp int) (int, error) {
+	var e Decimal
+	var err error
+	size := h.size
+	switch h.major {
+	case cborUint:
+		e, err = ctx.newFromCoefficient(0, exp, uint128T{lo: h.arg}, false)
+	case cborNegInt:
+		var c uint128T
+		c.add(&uint128T{lo: h.arg}, &uint128T{lo: 1})
+		e, err = ctx.newFromCoefficient(1, exp, c, false)
+	case cborTag:
+		if h.arg != cborPosBignum && h.arg != cborNegBignum {
+			return 0, fmt.Errorf("cbor: unsupported tag %d", h.arg)
+		}
+		s, serr := readCBORHead(b[size:])
+		if serr != nil {
+			return 0, serr
+		}
+		if s.major != cborBytes {
+			return 0, errors.New("cbor: bignum must be a byte string")
+		}
+		size += s.size
+		if s.arg > uint64(len(b)-size) {
+			return 0, errCBOREOF
+		}
+		i := new(big.Int).SetBytes(b[size : size+int(s.arg)])
+		size += int(s.arg)
+		if h.arg == cborNegBignum {
+			i.Not(i) // -1 - i
+		}
+		e, err = ctx.newFromBigInt(i, exp)
+	case cborSimple:
+		var ok bool
+		if e, ok = decodeCBORFloat(h); !ok {
+			return 0, fmt.Errorf("cbor: unsupported simple value %d", h.arg)
+		}
+	default:
+		return 0, fmt.Errorf("cbor: unsupported major type %d", h.major)
+	}
+	if err != nil && err != ErrInexact && err != ErrRange {
+		return 0, err
+	}
+	*d = e
+	return size, err
+}
+
+// decodeCBORFloat converts a half, single or double precision float.
+func decodeCBORFloat(h cborHead) (Decimal, bool) {
+	var f float64
+	switch h.info {
+	case cborFloat16:
+		sign, exp, frac := h.arg>>15, int(h.arg>>10&0x1f), h.arg&0x3ff
+		switch exp {
+		case 0:
+			f = math.Ldexp(float64(frac), -24)
+		case 0x1f:
+			switch {
+			case frac == 0:
+				return infinities[sign], true
+			case frac&0x200 != 0:
+				return QNaN, true
+			default:
+				return SNaN, true
+			}
+		default:
+			f = math.Ldexp(float64(frac|0x400), exp-25)
+		}
+		if sign != 0 {
+			f = -f
+		}
+	case cborFloat32:
+		f = float64(math.Float32frombits(uint32(h.arg)))
+	case cborFloat64:
+		f = math.Float64frombits(h.arg)
+	default:
+		return Decimal{}, false
+	}
+	switch {
+	case math.IsNaN(f):
+		return QNaN, true
+	case math.IsInf(f, 0):
+		return infinities[int8(math.Float64bits(f)>>63)], true
+	case f == 0 && math.Signbit(f):
+		return NegZero, true
+	}
+	return NewFromFloat64(f), true
+}
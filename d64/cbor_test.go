@@ -0,0 +1,99 @@
+package d64
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestDecimalAppendCBOR(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, d Decimal) {
+		t.Helper()
+		buf := d.AppendCBOR(nil)
+		equal(t, expected, hex.EncodeToString(buf))
+
+		var e Decimal
+		n, err := e.DecodeCBOR(append(buf, 0xff))
+		isnil(t, err)
+		equal(t, len(buf), n)
+		equal(t, d.bits, e.bits)
+	}
+
+	test("c482211a0001b211", MustParse("1111.21"))
+	test("c48221196ab3", MustParse("273.15"))
+	test("c4820001", One)
+	test("c482202e", MustParse("-1.50"))
+	test("c4820000", Zero)
+	test("c48219012c01", MustParse("1e300"))
+	test("c48219017f01", MustParse("1e383"))
+	test("c48239018d01", Min)
+	test("c482190171"+"1b002386f26fc0ffff", Max)
+	test("c482190171"+"3b002386f26fc0fffe", NegMax)
+	test("f97e00", QNaN)
+	test("f97d00", SNaN)
+	test("f97c00", Inf)
+	test("f9fc00", NegInf)
+	test("f98000", NegZero)
+}
+
+func TestDecimalDecodeCBOR(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, expectedErr error, s string) {
+		t.Helper()
+		b, err := hex.DecodeString(s)
+		isnil(t, err)
+		var d Decimal
+		n, err := d.DecodeCBOR(b)
+		check(t, errors.Is(err, expectedErr))
+		equal(t, len(b), n)
+		equal(t, expected, d.String())
+	}
+
+	test("100", nil, "1864")
+	test("-100", nil, "3863")
+	test("1.5", nil, "f93e00")
+	test("1.5", nil, "fa3fc00000")
+	test("1.5", nil, "fb3ff8000000000000")
+	test("6.103515625e-5", nil, "f90400")
+	test("-inf", nil, "fbfff0000000000000")
+	test("NaN", nil, "fb7ff8000000000000")
+	test("-0", nil, "fb8000000000000000")
+	test("1.844674407370955e+19", ErrInexact, "c249010000000000000000")
+	test("-1.844674407370955e+19", ErrInexact, "3bffffffffffffffff")
+	test("-1.844674407370955e+19", ErrInexact, "c349010000000000000000")
+	test("1.844674407370955", ErrInexact, "c48232c249010000000000000000")
+	test("1.5", nil, "c48220c2410f")
+	test("inf", ErrRange, "c48219018d01")
+	test("0", ErrInexact, "c4823a0000ffff01")
+	test("0", nil, "c4823bffffffffffffffff00")
+
+	invalid := func(s string) {
+		t.Helper()
+		b, err := hex.DecodeString(s)
+		isnil(t, err)
+		d := One
+		n, err := d.DecodeCBOR(b)
+		notnil(t, err)
+		equal(t, 0, n)
+		equalD64(t, One, d)
+	}
+
+	invalid("")
+	invalid("19")
+	invalid("1c")
+	invalid("c5820001")
+	invalid("c4830001")
+	invalid("c48200")
+	invalid("c482f93e00")
+	invalid("c48200f93e00")
+	invalid("c482004100")
+	invalid("c2")
+	invalid("c24901")
+	invalid("c26101")
+	invalid("4101")
+	invalid("f6")
+	invalid("c4820001"[:6])
+}
@@ -0,0 +1,193 @@
+// Package cobol converts between [d64.Decimal] and the packed (COMP-3) and
+// zoned decimal fields found in COBOL records.
+//
+// A field's layout is described by a [Picture], which mirrors the PIC clause
+// of the copybook that defines the record, e.g., PIC S9(7)V99.
+package cobol
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/anz-bank/decimal/d64"
+)
+
+// MaxDigits is the largest number of digits a [Picture] may have.
+const MaxDigits = 31
+
+var (
+	// ErrSign is reported when a field has an invalid sign, or when a
+	// negative number is encoded for an unsigned picture.
+	ErrSign = errors.New("cobol: invalid sign")
+
+	// ErrDigit is reported when a field holds something other than a
+	// decimal digit where a digit is expected.
+	ErrDigit = errors.New("cobol: invalid digit")
+
+	// ErrOverflow is reported when a number has too many integer digits
+	// for a picture.
+	ErrOverflow = errors.New("cobol: value overflows picture")
+
+	// ErrNotFinite is reported when NaN or ±∞ is encoded, since COBOL
+	// decimal fields can't represent them.
+	ErrNotFinite = errors.New("cobol: value not finite")
+)
+
+// Picture describes a numeric COBOL field as declared by a PIC clause.
+// For example, PIC S9(7)V99 has 9 digits, a scale of 2 and a sign.
+type Picture struct {
+	Digits int  // Total number of digits, including those after the V.
+	Scale  int  // Number of digits after the implied decimal point.
+	Signed bool // Whether the field carries a sign.
+}
+
+// ParsePicture parses a PIC clause character string such as "S9(7)V99",
+// "9(5)" or "S999V9(2)". A leading PIC or PICTURE keyword is ignored.
+func ParsePicture(s string) (Picture, error) {
+	t := strings.ToUpper(strings.TrimSpace(s))
+	for _, keyword := range []string{"PICTURE", "PIC"} {
+		if rest, ok := strings.CutPrefix(t, keyword); ok {
+			t = strings.TrimPrefix(strings.TrimSpace(rest), "IS ")
+			t = strings.TrimSpace(t)
+			break
+		}
+	}
+
+	var p Picture
+	if strings.HasPrefix(t, "S") {
+		p.Signed = true
+		t = t[1:]
+	}
+	point := false
+	for len(t) > 0 {
+		switch t[0] {
+		case '9':
+			n := 1
+			t = t[1:]
+			if strings.HasPrefix(t, "(") {
+				end := strings.IndexByte(t, ')')
+				if end < 0 {
+					return Picture{}, fmt.Errorf("cobol: unterminated repeat in picture %q", s)
+				}
+				var err error
+				n, err = strconv.Atoi(t[1:end])
+				if err != nil || n < 1 || n > MaxDigits {
+					return Picture{}, fmt.Errorf("cobol: invalid repeat in picture %q", s)
+				}
+				t = t[end+1:]
+			}
+			p.Digits += n
+			if point {
+				p.Scale += n
+			}
+			if p.Digits > MaxDigits {
+				return Picture{}, fmt.Errorf("cobol: picture %q has more than %d digits", s, MaxDigits)
+			}
+		case 'V':
+			if point {
+				return Picture{}, fmt.Errorf("cobol: picture %q has more than one V", s)
+			}
+			point = true
+			t = t[1:]
+		default:
+			return Picture{}, fmt.Errorf("cobol: unsupported symbol %q in picture %q", t[0], s)
+		}
+	}
+	if p.Digits == 0 {
+		return Picture{}, fmt.Errorf("cobol: picture %q has no digits", s)
+	}
+	return p, nil
+}
+
+// String returns p in the form S9(n)V9(m).
+func (p Picture) String() string {
+	var b strings.Builder
+	if p.Signed {
+		b.WriteByte('S')
+	}
+	if n := p.Digits - p.Scale; n > 0 {
+		fmt.Fprintf(&b, "9(%d)", n)
+	}
+	if p.Scale > 0 {
+		fmt.Fprintf(&b, "V9(%d)", p.Scale)
+	}
+	return b.String()
+}
+
+func (p Picture) check() error {
+	if p.Digits < 1 || p.Digits > MaxDigits || p.Scale < 0 || p.Scale > p.Digits {
+		return fmt.Errorf("cobol: invalid picture with %d digits and scale %d", p.Digits, p.Scale)
+	}
+	return nil
+}
+
+// digits writes the digits of |d| × 10^Scale into dst, which must be
+// p.Digits long, one digit per byte. p must be valid.
+func (p Picture) digits(dst []byte, d d64.Decimal) (neg bool, err error) {
+	var buf [8]byte
+	form, neg, coefficient, exp := d.Decompose(buf[:0])
+	if form != d64.FormFinite {
+		return false, fmt.Errorf("%w: %v", ErrNotFinite, d)
+	}
+	var c uint64
+	for _, b := range coefficient {
+		c = c<<8 | uint64(b)
+	}
+	if c == 0 {
+		clear(dst)
+		return false, nil
+	}
+	if neg && !p.Signed {
+		return false, fmt.Errorf("%w: %v for unsigned %v", ErrSign, d, p)
+	}
+
+	shift := int(exp) + p.Scale
+	if shift < 0 {
+		return false, fmt.Errorf("cobol: %v has more than %d decimal places: %w", d, p.Scale, d64.ErrInexact)
+	}
+	i := len(dst)
+	for ; shift > 0 && i > 0; shift-- {
+		i--
+		dst[i] = 0
+	}
+	for ; c > 0; c /= 10 {
+		if i == 0 || shift > 0 {
+			return false, fmt.Errorf("%w: %v for %v", ErrOverflow, d, p)
+		}
+		i--
+		dst[i] = byte(c % 10)
+	}
+	clear(dst[:i])
+	return neg, nil
+}
+
+// compose converts digits, one per byte, into a Decimal at scale p.Scale.
+func (p Picture) compose(digits []byte, neg bool) (d64.Decimal, error) {
+	var hi, lo uint64
+	for _, x := range digits {
+		h, l := bits.Mul64(lo, 10)
+		var carry uint64
+		lo, carry = bits.Add64(l, uint64(x), 0)
+		hi = hi*10 + h + carry
+	}
+	var coefficient [16]byte
+	binary.BigEndian.PutUint64(coefficient[:8], hi)
+	binary.BigEndian.PutUint64(coefficient[8:], lo)
+
+	var d d64.Decimal
+	if err := d.Compose(d64.FormFinite, neg, coefficient[:], int32(-p.Scale)); err != nil {
+		return d64.QNaN, fmt.Errorf("cobol: %v: %w", p, err)
+	}
+	return d, nil
+}
+
+func (p Picture) checkLen(b []byte, n int) error {
+	if len(b) != n {
+		return fmt.Errorf("cobol: %d bytes for %v, want %d", len(b), p, n)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package cobol
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anz-bank/decimal/d64"
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func TestParsePicture(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected Picture, s string) {
+		t.Helper()
+		p, err := ParsePicture(s)
+		expect.Nil(t, err)
+		expect.Equal(t, expected, p)
+
+		q, err := ParsePicture(p.String())
+		expect.Nil(t, err)
+		expect.Equal(t, p, q)
+	}
+
+	test(Picture{Digits: 9, Scale: 2, Signed: true}, "S9(7)V99")
+	test(Picture{Digits: 5}, "9(5)")
+	test(Picture{Digits: 5, Scale: 2, Signed: true}, "s999v9(2)")
+	test(Picture{Digits: 3, Scale: 3}, "V999")
+	test(Picture{Digits: 11, Scale: 2, Signed: true}, "PIC S9(9)V99")
+	test(Picture{Digits: 4}, " PICTURE IS 9999 ")
+	test(Picture{Digits: 31, Scale: 31}, "V9(31)")
+
+	for _, s := range []string{"", "S", "SV", "9(", "9()", "9(0)", "9(32)", "9(20)9(12)", "9V9V9", "X(5)", "99.99", "9(3"} {
+		_, err := ParsePicture(s)
+		if err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
+
+func TestPictureString(t *testing.T) {
+	t.Parallel()
+
+	expect.Equal(t, "S9(7)V9(2)", Picture{Digits: 9, Scale: 2, Signed: true}.String())
+	expect.Equal(t, "9(5)", Picture{Digits: 5}.String())
+	expect.Equal(t, "SV9(3)", Picture{Digits: 3, Scale: 3, Signed: true}.String())
+}
+
+func TestPictureInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range []Picture{
+		{}, {Digits: -1}, {Digits: MaxDigits + 1}, {Digits: 40, Signed: true},
+		{Digits: 5, Scale: 6}, {Digits: 5, Scale: -1},
+	} {
+		expected := fmt.Sprintf("cobol: invalid picture with %d digits and scale %d", p.Digits, p.Scale)
+		check := func(op string, err error) {
+			t.Helper()
+			if err == nil || err.Error() != expected {
+				t.Errorf("%s for %#v: expected %q, got %v", op, p, expected, err)
+			}
+		}
+		_, err := p.AppendPacked(nil, d64.One)
+		check("AppendPacked", err)
+		_, err = p.AppendZoned(nil, d64.One, EBCDIC)
+		check("AppendZoned", err)
+		_, err = p.DecodePacked([]byte{0x1c})
+		check("DecodePacked", err)
+		_, err = p.DecodeZoned([]byte{0xc1}, EBCDIC)
+		check("DecodeZoned", err)
+	}
+}
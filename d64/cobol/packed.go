@@ -0,0 +1,94 @@
+package cobol
+
+import (
+	"fmt"
+
+	"github.com/anz-bank/decimal/d64"
+)
+
+// Sign nibbles of packed fields and zones of EBCDIC zoned fields.
+const (
+	signPositive = 0xc
+	signNegative = 0xd
+	signUnsigned = 0xf
+)
+
+// PackedLen returns the number of bytes in a packed (COMP-3) field for p.
+func (p Picture) PackedLen() int {
+	return p.Digits/2 + 1
+}
+
+// AppendPacked appends d to buf as a packed (COMP-3) field for p.
+//
+// Each byte holds two digits, one per nibble, and the last nibble holds the
+// sign: C for positive and D for negative if p is signed, or F if it isn't.
+// If p has an even number of digits, the first nibble is zero.
+//
+// AppendPacked reports an error wrapping [d64.ErrInexact] if d has more
+// decimal places than p.Scale, [ErrOverflow] if it has too many integer
+// digits, and [ErrSign] if d is negative and p is unsigned.
+func (p Picture) AppendPacked(buf []byte, d d64.Decimal) ([]byte, error) {
+	if err := p.check(); err != nil {
+		return buf, err
+	}
+	var digits [MaxDigits + 1]byte
+	n := 2*p.PackedLen() - 1
+	neg, err := p.digits(digits[n-p.Digits:n], d)
+	if err != nil {
+		return buf, err
+	}
+
+	var sign byte = signUnsigned
+	if p.Signed {
+		sign = signPositive
+		if neg {
+			sign = signNegative
+		}
+	}
+	for i := 0; i < n-1; i += 2 {
+		buf = append(buf, digits[i]<<4|digits[i+1])
+	}
+	return append(buf, digits[n-1]<<4|sign), nil
+}
+
+// DecodePacked decodes a packed (COMP-3) field for p.
+//
+// Validation is strict. b must be exactly [Picture.PackedLen] bytes, every
+// digit nibble must be 0-9, the padding nibble of an even-digit picture must
+// be zero, and the sign nibble must be C or D if p is signed, or F if it
+// isn't. DecodePacked reports an error wrapping [d64.ErrInexact] if the value
+// has more than 16 significant digits.
+func (p Picture) DecodePacked(b []byte) (d64.Decimal, error) {
+	if err := p.check(); err != nil {
+		return d64.QNaN, err
+	}
+	if err := p.checkLen(b, p.PackedLen()); err != nil {
+		return d64.QNaN, err
+	}
+
+	var digits [MaxDigits + 1]byte
+	n := 2*len(b) - 1
+	for i, x := range b {
+		digits[2*i] = x >> 4
+		digits[2*i+1] = x & 0xf
+	}
+	for i, x := range digits[:n] {
+		if x > 9 {
+			return d64.QNaN, fmt.Errorf("%w: nibble %X at %d", ErrDigit, x, i)
+		}
+	}
+	if n > p.Digits && digits[0] != 0 {
+		return d64.QNaN, fmt.Errorf("%w: nonzero padding nibble", ErrDigit)
+	}
+
+	var neg bool
+	switch sign := digits[n]; {
+	case !p.Signed && sign == signUnsigned:
+	case p.Signed && sign == signPositive:
+	case p.Signed && sign == signNegative:
+		neg = true
+	default:
+		return d64.QNaN, fmt.Errorf("%w: nibble %X for %v", ErrSign, sign, p)
+	}
+	return p.compose(digits[:n], neg)
+}
@@ -0,0 +1,99 @@
+package cobol
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/anz-bank/decimal/d64"
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func TestPictureAppendPacked(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, pic, s string) {
+		t.Helper()
+		p, err := ParsePicture(pic)
+		expect.Nil(t, err)
+		d := d64.MustParse(s)
+		buf, err := p.AppendPacked(nil, d)
+		expect.Nil(t, err)
+		expect.Equal(t, expected, fmt.Sprintf("%X", buf))
+		expect.Equal(t, p.PackedLen(), len(buf))
+
+		e, err := p.DecodePacked(buf)
+		expect.Nil(t, err)
+		expect.Equal(t, true, d.Equal(e))
+	}
+
+	test("001234567C", "S9(7)V99", "12345.67")
+	test("001234567D", "S9(7)V99", "-12345.67")
+	test("000000000C", "S9(7)V99", "-0")
+	test("00125F", "9(3)V99", "1.25")
+	test("00500F", "9(3)V99", "5")
+	test("012C", "S99", "12")
+	test(strings.Repeat("0", 30)+"1F", "V9(30)", "1e-30")
+	test("1000000000000000000000000000000F", "9(31)", "1e30")
+
+	invalid := func(target error, pic, s string) {
+		t.Helper()
+		buf, err := ParsePicture(pic)
+		expect.Nil(t, err)
+		_, err = buf.AppendPacked(nil, d64.MustParse(s))
+		expect.ErrorIs(t, err, target)
+	}
+	invalid(d64.ErrInexact, "S9(7)V99", "1.234")
+	invalid(ErrOverflow, "S9(7)V99", "12345678")
+	invalid(ErrOverflow, "S99", "1e300")
+	invalid(ErrSign, "9(7)V99", "-1")
+	invalid(ErrNotFinite, "S9(7)V99", "NaN")
+	invalid(ErrNotFinite, "S9(7)V99", "-Inf")
+
+	_, err := Picture{}.AppendPacked(nil, d64.One)
+	if err == nil {
+		t.Error("expected error for zero Picture")
+	}
+}
+
+func TestPictureDecodePacked(t *testing.T) {
+	t.Parallel()
+
+	signed := Picture{Digits: 9, Scale: 2, Signed: true}
+	even := Picture{Digits: 4, Scale: 1, Signed: true}
+	unsigned := Picture{Digits: 5, Scale: 2}
+	wide := Picture{Digits: 19, Signed: true}
+
+	test := func(p Picture, expected string, b ...byte) {
+		t.Helper()
+		d, err := p.DecodePacked(b)
+		expect.Nil(t, err)
+		expect.Equal(t, expected, d.String())
+	}
+	invalid := func(target error, p Picture, b ...byte) {
+		t.Helper()
+		_, err := p.DecodePacked(b)
+		if target == nil {
+			if err == nil {
+				t.Errorf("expected error for % X", b)
+			}
+			return
+		}
+		expect.ErrorIs(t, err, target)
+	}
+
+	test(signed, "12345.67", 0x00, 0x12, 0x34, 0x56, 0x7c)
+	test(signed, "-0.01", 0x00, 0x00, 0x00, 0x00, 0x1d)
+	test(even, "-123.4", 0x01, 0x23, 0x4d)
+	test(unsigned, "999.99", 0x99, 0x99, 0x9f)
+	test(wide, "1.234567890123456e+18", 0x12, 0x34, 0x56, 0x78, 0x90, 0x12, 0x34, 0x56, 0x00, 0x0c)
+
+	invalid(ErrSign, signed, 0x00, 0x12, 0x34, 0x56, 0x7f)
+	invalid(ErrSign, signed, 0x00, 0x12, 0x34, 0x56, 0x7a)
+	invalid(ErrSign, unsigned, 0x99, 0x99, 0x9c)
+	invalid(ErrDigit, signed, 0x00, 0x12, 0x3a, 0x56, 0x7c)
+	invalid(ErrDigit, even, 0x11, 0x23, 0x4d)
+	invalid(d64.ErrInexact, wide, 0x12, 0x34, 0x56, 0x78, 0x90, 0x12, 0x34, 0x56, 0x78, 0x9c)
+	invalid(nil, signed, 0x12, 0x34, 0x56, 0x7c)
+	invalid(nil, Picture{Digits: 32}, make([]byte, 17)...)
+}
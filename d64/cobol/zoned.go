@@ -0,0 +1,162 @@
+package cobol
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anz-bank/decimal/d64"
+)
+
+// Charset selects the character encoding of a zoned decimal field.
+type Charset int8
+
+const (
+	// EBCDIC zoned fields hold digits as F0-F9. The sign of a signed field
+	// is in the zone of the last byte: C for positive and D for negative.
+	EBCDIC Charset = iota
+
+	// ASCII zoned fields hold digits as '0'-'9'. The sign of a signed field
+	// is overpunched on the last byte: '{' and 'A'-'I' for positive 0-9 and
+	// '}' and 'J'-'R' for negative 0-9.
+	ASCII
+)
+
+func (cs Charset) String() string {
+	switch cs {
+	case EBCDIC:
+		return "EBCDIC"
+	case ASCII:
+		return "ASCII"
+	default:
+		return fmt.Sprintf("Unknown charset %d", cs)
+	}
+}
+
+const (
+	asciiPositive = "{ABCDEFGHI"
+	asciiNegative = "}JKLMNOPQR"
+)
+
+// ZonedLen returns the number of bytes in a zoned decimal field for p.
+func (p Picture) ZonedLen() int {
+	return p.Digits
+}
+
+// AppendZoned appends d to buf as a zoned decimal field for p, with one
+// byte per digit and the sign, if any, overpunched on the last byte.
+// It reports the same errors as [Picture.AppendPacked].
+func (p Picture) AppendZoned(buf []byte, d d64.Decimal, cs Charset) ([]byte, error) {
+	if err := p.check(); err != nil {
+		return buf, err
+	}
+	if err := cs.check(); err != nil {
+		return buf, err
+	}
+	var digits [MaxDigits]byte
+	neg, err := p.digits(digits[:p.Digits], d)
+	if err != nil {
+		return buf, err
+	}
+
+	last := digits[p.Digits-1]
+	for _, x := range digits[:p.Digits-1] {
+		if cs == EBCDIC {
+			buf = append(buf, signUnsigned<<4|x)
+		} else {
+			buf = append(buf, '0'+x)
+		}
+	}
+	switch {
+	case cs == EBCDIC && !p.Signed:
+		return append(buf, signUnsigned<<4|last), nil
+	case cs == EBCDIC && neg:
+		return append(buf, signNegative<<4|last), nil
+	case cs == EBCDIC:
+		return append(buf, signPositive<<4|last), nil
+	case !p.Signed:
+		return append(buf, '0'+last), nil
+	case neg:
+		return append(buf, asciiNegative[last]), nil
+	default:
+		return append(buf, asciiPositive[last]), nil
+	}
+}
+
+// DecodeZoned decodes a zoned decimal field for p.
+//
+// Validation is strict. b must be exactly [Picture.ZonedLen] bytes and every
+// byte must be a digit in cs. The last byte of a signed field must carry a
+// positive or negative sign, and that of an unsigned field must not.
+// DecodeZoned reports an error wrapping [d64.ErrInexact] if the value has
+// more than 16 significant digits.
+func (p Picture) DecodeZoned(b []byte, cs Charset) (d64.Decimal, error) {
+	if err := p.check(); err != nil {
+		return d64.QNaN, err
+	}
+	if err := cs.check(); err != nil {
+		return d64.QNaN, err
+	}
+	if err := p.checkLen(b, p.ZonedLen()); err != nil {
+		return d64.QNaN, err
+	}
+
+	var digits [MaxDigits]byte
+	n := len(b) - 1
+	for i, x := range b[:n] {
+		d, ok := cs.digit(x)
+		if !ok {
+			return d64.QNaN, fmt.Errorf("%w: byte %#02x at %d", ErrDigit, x, i)
+		}
+		digits[i] = d
+	}
+
+	var neg, ok bool
+	last := b[n]
+	if p.Signed {
+		digits[n], neg, ok = cs.overpunch(last)
+	} else {
+		digits[n], ok = cs.digit(last)
+	}
+	if !ok {
+		return d64.QNaN, fmt.Errorf("%w: byte %#02x for %v", ErrSign, last, p)
+	}
+	return p.compose(digits[:len(b)], neg)
+}
+
+func (cs Charset) check() error {
+	if cs != EBCDIC && cs != ASCII {
+		return fmt.Errorf("cobol: invalid charset %d", cs)
+	}
+	return nil
+}
+
+// digit decodes an unsigned zoned digit.
+func (cs Charset) digit(x byte) (byte, bool) {
+	if cs == EBCDIC {
+		return x & 0xf, x>>4 == signUnsigned && x&0xf <= 9
+	}
+	return x - '0', '0' <= x && x <= '9'
+}
+
+// overpunch decodes a zoned digit carrying a sign.
+func (cs Charset) overpunch(x byte) (digit byte, neg, ok bool) {
+	if cs == EBCDIC {
+		if x&0xf > 9 {
+			return 0, false, false
+		}
+		switch x >> 4 {
+		case signPositive:
+			return x & 0xf, false, true
+		case signNegative:
+			return x & 0xf, true, true
+		}
+		return 0, false, false
+	}
+	if i := strings.IndexByte(asciiPositive, x); i >= 0 {
+		return byte(i), false, true
+	}
+	if i := strings.IndexByte(asciiNegative, x); i >= 0 {
+		return byte(i), true, true
+	}
+	return 0, false, false
+}
@@ -0,0 +1,96 @@
+package cobol
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anz-bank/decimal/d64"
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func TestPictureAppendZoned(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, pic string, cs Charset, s string) {
+		t.Helper()
+		p, err := ParsePicture(pic)
+		expect.Nil(t, err)
+		d := d64.MustParse(s)
+		buf, err := p.AppendZoned(nil, d, cs)
+		expect.Nil(t, err)
+		if cs == ASCII {
+			expect.Equal(t, expected, string(buf))
+		} else {
+			expect.Equal(t, expected, fmt.Sprintf("%X", buf))
+		}
+		expect.Equal(t, p.ZonedLen(), len(buf))
+
+		e, err := p.DecodeZoned(buf, cs)
+		expect.Nil(t, err)
+		expect.Equal(t, true, d.Equal(e))
+	}
+
+	test("F0F0F1F2F3F4F5F6C7", "S9(7)V99", EBCDIC, "12345.67")
+	test("F0F0F1F2F3F4F5F6D7", "S9(7)V99", EBCDIC, "-12345.67")
+	test("F1F2F5", "9V99", EBCDIC, "1.25")
+	test("00123456G", "S9(7)V99", ASCII, "12345.67")
+	test("00123456P", "S9(7)V99", ASCII, "-12345.67")
+	test("00000000{", "S9(7)V99", ASCII, "0")
+	test("0000001}", "S9(6)V99", ASCII, "-0.1")
+	test("125", "9V99", ASCII, "1.25")
+
+	p := Picture{Digits: 3, Scale: 2}
+	_, err := p.AppendZoned(nil, d64.MustParse("1.234"), ASCII)
+	expect.ErrorIs(t, err, d64.ErrInexact)
+	_, err = p.AppendZoned(nil, d64.MustParse("-1"), EBCDIC)
+	expect.ErrorIs(t, err, ErrSign)
+	_, err = p.AppendZoned(nil, d64.MustParse("10"), EBCDIC)
+	expect.ErrorIs(t, err, ErrOverflow)
+	_, err = p.AppendZoned(nil, d64.One, Charset(7))
+	if err == nil {
+		t.Error("expected error for invalid charset")
+	}
+}
+
+func TestPictureDecodeZoned(t *testing.T) {
+	t.Parallel()
+
+	signed := Picture{Digits: 4, Scale: 2, Signed: true}
+	unsigned := Picture{Digits: 4, Scale: 2}
+
+	test := func(p Picture, cs Charset, expected string, b string) {
+		t.Helper()
+		d, err := p.DecodeZoned([]byte(b), cs)
+		expect.Nil(t, err)
+		expect.Equal(t, expected, d.String())
+	}
+	invalid := func(target error, p Picture, cs Charset, b string) {
+		t.Helper()
+		_, err := p.DecodeZoned([]byte(b), cs)
+		if target == nil {
+			if err == nil {
+				t.Errorf("expected error for %q", b)
+			}
+			return
+		}
+		expect.ErrorIs(t, err, target)
+	}
+
+	test(signed, EBCDIC, "12.34", "\xf1\xf2\xf3\xc4")
+	test(signed, EBCDIC, "-12.34", "\xf1\xf2\xf3\xd4")
+	test(unsigned, EBCDIC, "12.34", "\xf1\xf2\xf3\xf4")
+	test(signed, ASCII, "12.34", "123D")
+	test(signed, ASCII, "-12.3", "123}")
+	test(unsigned, ASCII, "0.01", "0001")
+
+	invalid(ErrSign, signed, EBCDIC, "\xf1\xf2\xf3\xf4")
+	invalid(ErrSign, signed, EBCDIC, "\xf1\xf2\xf3\xa4")
+	invalid(ErrSign, unsigned, EBCDIC, "\xf1\xf2\xf3\xc4")
+	invalid(ErrDigit, signed, EBCDIC, "\xf1\xc2\xf3\xc4")
+	invalid(ErrDigit, signed, EBCDIC, "\xf1\xfa\xf3\xc4")
+	invalid(ErrSign, signed, ASCII, "1234")
+	invalid(ErrSign, unsigned, ASCII, "123D")
+	invalid(ErrDigit, unsigned, ASCII, "1 34")
+	invalid(nil, signed, ASCII, "123")
+	invalid(nil, signed, Charset(-1), "123D")
+}
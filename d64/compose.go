@@ -0,0 +1,95 @@
+package d64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// decimalDecomposer and decimalComposer are the informal interfaces that
+// SQL drivers such as pgx and go-mssqldb use to transfer decimals exactly.
+type decimalDecomposer interface {
+	Decompose(buf []byte) (form byte, negative bool, coefficient []byte, exponent int32)
+}
+
+type decimalComposer interface {
+	Compose(form byte, negative bool, coefficient []byte, exponent int32) error
+}
+
+var _ decimalDecomposer = Zero
+var _ decimalComposer = (*Decimal)(nil)
+
+// Forms reported by [Decimal.Decompose] and accepted by [Decimal.Compose].
+const (
+	FormFinite   byte = 0
+	FormInfinite byte = 1
+	FormNaN      byte = 2
+)
+
+// Decompose returns the internal decimal state in parts.
+// The coefficient is a big-endian unsigned integer such that d equals
+// ±coefficient × 10^exponent. Trailing zeros are removed from the coefficient
+// without raising a negative exponent above zero.
+// If buf has a capacity of at least 8 bytes, the coefficient is written into it.
+func (d Decimal) Decompose(buf []byte) (form byte, negative bool, coefficient []byte, exponent int32) {
+	var dp decParts
+	dp.unpack(d)
+	negative = dp.sign == 1
+	switch {
+	case dp.fl == flInf:
+		return FormInfinite, negative, nil, 0
+	case dp.fl.nan():
+		return FormNaN, negative, nil, 0
+	}
+
+	dp.trimZeros()
+	if cap(buf) < 8 {
+		buf = make([]byte, 8)
+	}
+	buf = buf[:8]
+	binary.BigEndian.PutUint64(buf, dp.significand.lo)
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	return FormFinite, negative, buf[i:], int32(dp.exp)
+}
+
+// Compose sets d from parts as returned by [Decimal.Decompose].
+// It returns [ErrInexact] if the coefficient has more than 16 significant
+// digits and [ErrRange] if the value lies outside the range of Decimal.
+// In both cases d is left unchanged.
+func (d *Decimal) Compose(form byte, negative bool, coefficient []byte, exponent int32) error {
+	var sign int8
+	if negative {
+		sign = 1
+	}
+	switch form {
+	case FormFinite:
+	case FormInfinite:
+		*d = infinities[sign]
+		return nil
+	case FormNaN:
+		*d = QNaN
+		return nil
+	default:
+		return fmt.Errorf("unknown decimal form %d", form)
+	}
+
+	for len(coefficient) > 0 && coefficient[0] == 0 {
+		coefficient = coefficient[1:]
+	}
+	if len(coefficient) > 16 {
+		return ErrRange
+	}
+	var c uint128T
+	for _, b := range coefficient {
+		c.shl(&c, 8)
+		c.lo |= uint64(b)
+	}
+	e, err := DefaultContext.newFromCoefficient(sign, int(exponent), c, false)
+	if err != nil {
+		return err
+	}
+	*d = e
+	return nil
+}
@@ -0,0 +1,74 @@
+package d64
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecimalDecompose(t *testing.T) {
+	t.Parallel()
+
+	test := func(expectedCoefficient uint64, expectedExponent int32, negative bool, d Decimal) {
+		t.Helper()
+		var buf [8]byte
+		form, neg, coefficient, exponent := d.Decompose(buf[:0])
+		equal(t, FormFinite, form)
+		equal(t, negative, neg)
+		var c uint64
+		for _, b := range coefficient {
+			c = c<<8 | uint64(b)
+		}
+		equal(t, expectedCoefficient, c)
+		equal(t, expectedExponent, exponent)
+	}
+
+	test(1, 0, false, One)
+	test(12345, -2, true, MustParse("-123.45"))
+	test(1000, 0, false, NewFromInt64(1000))
+	test(1, 300, false, MustParse("1e300"))
+	test(0, 0, true, NegZero)
+	test(1, -398, false, Min)
+
+	form, neg, _, _ := NegInf.Decompose(nil)
+	equal(t, FormInfinite, form)
+	equal(t, true, neg)
+	form, _, _, _ = QNaN.Decompose(nil)
+	equal(t, FormNaN, form)
+}
+
+func TestDecimalCompose(t *testing.T) {
+	t.Parallel()
+
+	roundTrip := func(d Decimal) {
+		t.Helper()
+		var e Decimal
+		isnil(t, e.Compose(d.Decompose(nil)))
+		equal(t, d, e)
+	}
+
+	roundTrip(Zero)
+	roundTrip(NegZero)
+	roundTrip(One)
+	roundTrip(Pi)
+	roundTrip(Max)
+	roundTrip(NegMin)
+	roundTrip(MustParse("-1234.5678"))
+	roundTrip(Inf)
+	roundTrip(NegInf)
+
+	var d Decimal
+	// 12345678901234560 has 17 digits, but the last is a zero.
+	isnil(t, d.Compose(FormFinite, false, []byte{0, 0x2b, 0xdc, 0x54, 0x5d, 0x6b, 0x4b, 0x80}, -1))
+	equalD64(t, MustParse("1234567890123456"), d)
+
+	isnil(t, d.Compose(FormNaN, false, nil, 0))
+	check(t, d.IsNaN())
+
+	d = One
+	// 12345678901234567 needs 17 digits.
+	check(t, errors.Is(d.Compose(FormFinite, false, []byte{0x2b, 0xdc, 0x54, 0x5d, 0x6b, 0x4b, 0x87}, 0), ErrInexact))
+	check(t, errors.Is(d.Compose(FormFinite, false, []byte{1}, 385), ErrRange))
+	check(t, errors.Is(d.Compose(FormFinite, false, []byte{1}, -399), ErrInexact))
+	notnil(t, d.Compose(3, false, nil, 0))
+	equal(t, One, d)
+}
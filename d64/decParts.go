@@ -33,6 +33,8 @@ func (ans *decParts) add128(dp, ep *decParts) {
 			ans.sign = dp.sign
 			ans.significand.sub(&dp.significand, &ep.significand)
 		} else {
+			// Exact cancellation yields +0.
+			ans.sign = 0
 			ans.significand = uint128T{0, 0}
 		}
 	}
@@ -144,6 +146,22 @@ func (dp *decParts) removeZeros() {
 	dp.exp = e
 }
 
+// trimZeros removes trailing zeros from a finite dp. A negative exponent is
+// never raised above zero, so integers keep an exponent of zero.
+func (dp *decParts) trimZeros() {
+	switch {
+	case dp.significand.lo == 0:
+		dp.exp = 0
+	case dp.exp >= 0:
+		dp.removeZeros()
+	default:
+		for dp.exp < 0 && dp.significand.lo%10 == 0 {
+			dp.significand.lo /= 10
+			dp.exp++
+		}
+	}
+}
+
 // isinf returns true if the decimal is an infinty
 func (dp *decParts) isinf() bool {
 	return dp.fl == flInf
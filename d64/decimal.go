@@ -243,6 +243,70 @@ func roundStatus(significand uint64, expDiff int16) discardedDigit {
 	return gt5
 }
 
+// newFromCoefficient returns (-1)^sign × c × 10^exp, rounding c to 16 digits
+// with ctx where necessary. The sticky argument indicates that nonzero digits
+// below c were already discarded by the caller.
+// The result is accompanied by [ErrInexact] if rounding discarded nonzero
+// digits and by [ErrRange] if the value overflowed to ±∞.
+func (ctx Context) newFromCoefficient(sign int8, exp int, c uint128T, sticky bool) (Decimal, error) {
+	if (c == uint128T{}) {
+		if sticky {
+			return zeroes[sign], ErrInexact
+		}
+		return zeroes[sign], nil
+	}
+
+	rndStatus := eq0
+	digits := c.numDecimalDigits()
+	if drop := max(digits-decimalDigits, -expOffset-exp); drop > 0 {
+		var digit uint64
+		if drop > digits {
+			c = uint128T{}
+			sticky = true
+		} else {
+			// Discard all but the rounding digit, then expose it.
+			for n := drop - 1; n > 0; {
+				step := min(n, 19)
+				if c.divrem64(&c, tenToThe[step]) != 0 {
+					sticky = true
+				}
+				n -= step
+			}
+			digit = c.divrem64(&c, 10)
+		}
+		exp += drop
+		switch {
+		case digit == 0 && !sticky:
+		case digit < 5:
+			rndStatus = lt5
+		case digit == 5 && !sticky:
+			rndStatus = eq5
+		default:
+			rndStatus = gt5
+		}
+	} else if sticky {
+		rndStatus = lt5
+	}
+
+	var err error
+	if rndStatus != eq0 {
+		err = ErrInexact
+	}
+	significand := ctx.Rounding.round(c.lo, rndStatus)
+	if significand > maxSig {
+		significand /= 10
+		exp++
+	}
+	if significand == 0 {
+		return zeroes[sign], err
+	}
+	if exp+numDecimalDigitsU64(significand)-1 > expMax+decimalDigits-1 {
+		return infinities[sign], ErrRange
+	}
+	e, s := renormalize(int16(exp), significand)
+	return newFromParts(sign, e, s), err
+}
+
 func newFromParts(sign int8, exp int16, significand uint64) Decimal {
 	return newDec(newFromPartsRaw(sign, exp, significand).bits)
 }
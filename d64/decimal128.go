@@ -0,0 +1,114 @@
+package d64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// IEEE 754 decimal128 BID encoding, as used by the BSON Decimal128 type.
+const (
+	d128ExpOffset   = 6176
+	d128ExpMask     = 1<<14 - 1
+	d128CoefMask    = 1<<49 - 1
+	d128PayloadMask = 1<<46 - 1
+	d64PayloadMask  = 1<<50 - 1
+	nanMask         = 0x7e << 56
+)
+
+// d128MaxCoefficient is 10³⁴-1, the largest canonical decimal128 coefficient.
+var d128MaxCoefficient = func() uint128T {
+	var c uint128T
+	one := uint128T{lo: 1}
+	return *c.sub(&tenToThe128[34], &one)
+}()
+
+// Decimal128 returns d as the high and low 64-bit words of an IEEE 754
+// decimal128 in BID encoding.
+// The conversion is always exact. The coefficient has trailing zeros removed,
+// without raising a negative exponent above zero, so 1.50 encodes as 15E-1
+// and 1000 encodes as 1000E0.
+// NaN payloads are preserved.
+func (d Decimal) Decimal128() (hi, lo uint64) {
+	var dp decParts
+	dp.unpack(d)
+	hi = uint64(dp.sign) << 63
+	switch {
+	case dp.fl == flInf:
+		return hi | inf, 0
+	case dp.fl.nan():
+		return hi | d.bits&nanMask, d.bits & d64PayloadMask
+	}
+	dp.trimZeros()
+	return hi | uint64(int(dp.exp)+d128ExpOffset)<<49, dp.significand.lo
+}
+
+// AppendDecimal128 appends the 16-byte little-endian BSON Decimal128
+// encoding of d to buf.
+func (d Decimal) AppendDecimal128(buf []byte) []byte {
+	hi, lo := d.Decimal128()
+	buf = binary.LittleEndian.AppendUint64(buf, lo)
+	return binary.LittleEndian.AppendUint64(buf, hi)
+}
+
+// NewFromDecimal128 converts an IEEE 754 decimal128 in BID encoding, given as
+// its high and low 64-bit words, to a [Decimal].
+// It uses [DefaultScanContext].
+func NewFromDecimal128(hi, lo uint64) (Decimal, error) {
+	return DefaultScanContext.NewFromDecimal128(hi, lo)
+}
+
+// NewFromDecimal128 converts an IEEE 754 decimal128 in BID encoding, given as
+// its high and low 64-bit words, to a [Decimal].
+//
+// Values with more than 16 significant digits are rounded according to ctx
+// and reported with [ErrInexact]. Values too large for a [Decimal] become ±∞
+// and are reported with [ErrRange]. In both cases the converted value is
+// still returned, so callers that accept rounding can ignore ErrInexact.
+// Non-canonical coefficients are treated as zero, as the standard requires.
+// NaN payloads too large for a [Decimal] are dropped.
+func (ctx Context) NewFromDecimal128(hi, lo uint64) (Decimal, error) {
+	sign := int8(hi >> 63)
+	s := hi & neg
+	switch hi >> 58 & 0x1f {
+	case 0x1e:
+		return infinities[sign], nil
+	case 0x1f:
+		nan := s | hi&nanMask
+		if hi&d128PayloadMask == 0 && lo < decimalBase {
+			nan |= lo
+		}
+		return newDec(nan), nil
+	}
+
+	var exp int
+	var c uint128T
+	if hi>>61&3 == 3 {
+		// The implied coefficient is always above 10³⁴-1, so it is
+		// non-canonical and reads as zero.
+		exp = int(hi >> 47 & d128ExpMask)
+	} else {
+		exp = int(hi >> 49 & d128ExpMask)
+		c = uint128T{lo: lo, hi: hi & d128CoefMask}
+		if d128MaxCoefficient.lt(&c) {
+			c = uint128T{}
+		}
+	}
+	return ctx.newFromCoefficient(sign, exp-d128ExpOffset, c, false)
+}
+
+// DecodeDecimal128 decodes a 16-byte little-endian BSON Decimal128 value.
+// It uses [DefaultScanContext].
+func DecodeDecimal128(b []byte) (Decimal, error) {
+	return DefaultScanContext.DecodeDecimal128(b)
+}
+
+// DecodeDecimal128 decodes a 16-byte little-endian BSON Decimal128 value.
+// See [Context.NewFromDecimal128] for how values are narrowed.
+func (ctx Context) DecodeDecimal128(b []byte) (Decimal, error) {
+	if len(b) != 16 {
+		return QNaN, fmt.Errorf("decimal128 must be 16 bytes, got %d", len(b))
+	}
+	lo := binary.LittleEndian.Uint64(b)
+	hi := binary.LittleEndian.Uint64(b[8:])
+	return ctx.NewFromDecimal128(hi, lo)
+}
@@ -0,0 +1,88 @@
+package d64
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecimalDecimal128(t *testing.T) {
+	t.Parallel()
+
+	test := func(expectedHi, expectedLo uint64, d Decimal) {
+		t.Helper()
+		hi, lo := d.Decimal128()
+		equal(t, expectedHi, hi)
+		equal(t, expectedLo, lo)
+
+		e, err := NewFromDecimal128(hi, lo)
+		isnil(t, err)
+		equal(t, d.bits, e.bits)
+	}
+
+	test(0x3040000000000000, 0, Zero)
+	test(0xb040000000000000, 0, NegZero)
+	test(0x3040000000000000, 1, One)
+	test(0xb03e000000000000, 15, MustParse("-1.50"))
+	test(0x3040000000000000, 1000, NewFromInt64(1000))
+	test(0x3040000000000000, 1234567890123456, MustParse("1234567890123456"))
+	test((d128ExpOffset+expMax)<<49, maxSig, Max)
+	test((d128ExpOffset-expOffset)<<49, 1, Min)
+	test(0x7800000000000000, 0, Inf)
+	test(0xf800000000000000, 0, NegInf)
+	test(0x7c00000000000000, 0, QNaN)
+	test(0x7e00000000000000, 0, SNaN)
+	test(0x7c00000000000000, 42, MustParse("NaN42"))
+	test(0xfe00000000000000, 0, newDec(neg|SNaN.bits))
+}
+
+func TestDecimalAppendDecimal128(t *testing.T) {
+	t.Parallel()
+
+	buf := MustParse("-1.50").AppendDecimal128([]byte{0xff})
+	equal(t, string([]byte{
+		0xff,
+		15, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x3e, 0xb0,
+	}), string(buf))
+
+	d, err := DecodeDecimal128(buf[1:])
+	isnil(t, err)
+	equalD64(t, MustParse("-1.5"), d)
+
+	_, err = DecodeDecimal128(buf)
+	notnil(t, err)
+	_, err = DecodeDecimal128(nil)
+	notnil(t, err)
+}
+
+func TestNewFromDecimal128(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, expectedErr error, ctx Context, hi, lo uint64) {
+		t.Helper()
+		d, err := ctx.NewFromDecimal128(hi, lo)
+		check(t, errors.Is(err, expectedErr))
+		equal(t, expected, d.String())
+	}
+
+	const one = 0x3040000000000000
+
+	// 12345678901234567 × 10⁰ needs 17 digits.
+	test("1.234567890123457e+16", ErrInexact, DefaultContext, one, 12345678901234567)
+	test("1.234567890123456e+16", ErrInexact, Context{Rounding: Down}, one, 12345678901234567)
+	// 10³³ × 10⁻³³ is exactly one.
+	c := tenToThe128[33]
+	test("1", nil, DefaultContext, one-33<<49|c.hi, c.lo)
+	// 1 × 10⁴⁰⁰ overflows.
+	test("inf", ErrRange, DefaultContext, one+400<<49, 1)
+	test("-inf", ErrRange, DefaultContext, 1<<63|one+400<<49, 1)
+	// 1 × 10⁻⁴⁰⁰ underflows.
+	test("0", ErrInexact, DefaultContext, one-400<<49, 1)
+	test("1e-398", ErrInexact, DefaultContext, one-399<<49, 7)
+	// Non-canonical coefficients read as zero.
+	test("0", nil, DefaultContext, 0x6000000000000000|one>>2, 1)
+	test("0", nil, DefaultContext, one|d128CoefMask, ^uint64(0))
+	// Large NaN payloads are dropped.
+	test("NaN", nil, DefaultContext, 0x7c00000000000001, 0)
+	test("NaN", nil, DefaultContext, 0x7c00000000000000, decimalBase)
+}
@@ -0,0 +1,359 @@
+package d64
+
+import "strings"
+
+type knownFailure uint8
+
+const (
+	notKnown knownFailure = iota
+
+	// lostExponent marks cases whose expected result preserves an exponent.
+	// A [Decimal] keeps only the value, so they give the same value without
+	// the trailing zeros.
+	lostExponent
+
+	// unknownKeyword marks cases that spell a keyword in a case [Parse]
+	// doesn't accept.
+	unknownKeyword
+
+	// extraKeyword marks cases that expect a syntax error for a keyword
+	// [Parse] accepts.
+	extraKeyword
+)
+
+// knownFailures lists the dectest cases whose expected results this package
+// doesn't reproduce. A listed case that starts passing fails, so the list
+// can't go stale.
+var knownFailures = knownFailureSet(map[knownFailure]string{
+	lostExponent: `
+		ddabs003 ddabs004 ddabs006 ddabs007 ddabs008 ddabs012 ddabs013
+		ddabs015 ddabs016 ddabs017 ddabs113 ddabs114 ddabs115 ddabs116
+		ddabs117 ddabs118 ddabs133 ddabs136
+		ddadd007 ddadd008 ddadd010 ddadd011 ddadd012 ddadd033 ddadd035
+		ddadd036 ddadd037 ddadd040 ddadd041 ddadd042 ddadd053 ddadd055
+		ddadd057 ddadd072 ddadd073 ddadd074 ddadd077 ddadd078 ddadd079
+		ddadd108 ddadd109 ddadd110 ddadd111 ddadd133 ddadd134 ddadd135
+		ddadd136 ddadd137 ddadd138 ddadd139 ddadd140 ddadd141 ddadd142
+		ddadd143 ddadd144 ddadd146 ddadd147 ddadd148 ddadd149 ddadd150
+		ddadd151 ddadd155 ddadd159 ddadd160 ddadd173 ddadd321 ddadd326
+		ddadd331 ddadd336 ddadd342 ddadd343 ddadd346 ddadd347 ddadd351
+		ddadd360 ddadd361 ddadd362 ddadd363 ddadd364 ddadd380 ddadd381
+		ddadd382 ddadd383 ddadd384 ddadd404 ddadd405 ddadd406 ddadd413
+		ddadd414 ddadd415 ddadd416 ddadd424 ddadd425 ddadd426 ddadd433
+		ddadd434 ddadd435 ddadd436 ddadd6445 ddadd6446 ddadd6454 ddadd6455
+		ddadd6456 ddadd6465 ddadd6466 ddadd6474 ddadd6475 ddadd6476 ddadd6485
+		ddadd6486 ddadd6494 ddadd6495 ddadd6496 ddadd6505 ddadd6506 ddadd6514
+		ddadd6515 ddadd6516 ddadd7577 ddadd7701 ddadd7702 ddadd7703 ddadd7704
+		ddadd7710 ddadd7711 ddadd7713 ddadd7714 ddadd7715 ddadd7716 ddadd7717
+		ddadd7718 ddadd7720 ddadd7721 ddadd7723 ddadd7724 ddadd7725 ddadd7726
+		ddadd7727 ddadd7728 ddadd7729 ddadd7731 ddadd7751 ddadd7752 ddadd7753
+		ddadd7754 ddadd7755 ddadd7756 ddadd7757 ddadd7758 ddadd7761 ddadd7762
+		ddadd7763 ddadd7764 ddadd7765 ddadd7766 ddadd7767 ddadd7768 ddadd7771
+		ddadd7772 ddadd7773 ddadd7774 ddadd7775 ddadd7776 ddadd7777 ddadd7778
+		ddadd71300 ddadd71325 ddadd71326 ddadd71327 ddadd71328 ddadd71329
+		ddadd71330 ddadd71331 ddadd71332 ddadd71333 ddadd71334 ddadd71335
+		ddadd71336 ddadd71337 ddadd71338 ddadd71339 ddadd71365 ddadd71367
+		ddadd71368 ddadd71369 ddadd71370 ddadd71371 ddadd71372 ddadd71373
+		ddadd71374 ddadd71375 ddadd71376 ddadd71377 ddadd71378 ddadd71379
+		ddadd71380 ddadd71381 ddadd71382 ddadd71383 ddadd71384 ddadd71385
+		ddadd71386 ddadd71387 ddadd71388 ddadd71389 ddadd71390 ddadd71391
+		ddadd71392 ddadd71393 ddadd71394 ddadd71395 ddadd71396 ddadd71500
+		ddadd71501 ddadd71502 ddadd71503 ddadd71540 ddadd71541 ddadd71542
+		ddadd71543 ddadd75057
+		ddbas003 ddbas004 ddbas007 ddbas013 ddbas018 ddbas019 ddbas022
+		ddbas031 ddbas053 ddbas056 ddbas057 ddbas058 ddbas068 ddbas130
+		ddbas131 ddbas132 ddbas133 ddbas134 ddbas135 ddbas136 ddbas137
+		ddbas138 ddbas139 ddbas140 ddbas141 ddbas143 ddbas144 ddbas145
+		ddbas146 ddbas148 ddbas149 ddbas150 ddbas151 ddbas153 ddbas154
+		ddbas155 ddbas156 ddbas157 ddbas158 ddbas160 ddbas161 ddbas162
+		ddbas163 ddbas164 ddbas165 ddbas166 ddbas167 ddbas168 ddbas169
+		ddbas181 ddbas182 ddbas200 ddbas201 ddbas202 ddbas219 ddbas220
+		ddbas221 ddbas222 ddbas238 ddbas239 ddbas240 ddbas241 ddbas242
+		ddbas262 ddbas290 ddbas291 ddbas292 ddbas293 ddbas294 ddbas295
+		ddbas296 ddbas297 ddbas298 ddbas299 ddbas301 ddbas302 ddbas303
+		ddbas304 ddbas305 ddbas306 ddbas307 ddbas308 ddbas309 ddbas310
+		ddbas311 ddbas312 ddbas313 ddbas314 ddbas315 ddbas316 ddbas317
+		ddbas318 ddbas319 ddbas320 ddbas321 ddbas322 ddbas323 ddbas327
+		ddbas328 ddbas329 ddbas330 ddbas331 ddbas332 ddbas333 ddbas334
+		ddbas335 ddbas336 ddbas337 ddbas338 ddbas339 ddbas340 ddbas341
+		ddbas343 ddbas345 ddbas346 ddbas347 ddbas349 ddbas351 ddbas352
+		ddbas361 ddbas362 ddbas363 ddbas364 ddbas365 ddbas366 ddbas367
+		ddbas368 ddbas369 ddbas370 ddbas371 ddbas372 ddbas373 ddbas374
+		ddbas375 ddbas376 ddbas377 ddbas378 ddbas379 ddbas380 ddbas381
+		ddbas383 ddbas426 ddbas427 ddbas440 ddbas441 ddbas442 ddbas443
+		ddbas444 ddbas445 ddbas452 ddbas453 ddbas454 ddbas455 ddbas456
+		ddbas457 ddbas458 ddbas459 ddbas460 ddbas461 ddbas462 ddbas463
+		ddbas468 ddbas469 ddbas470 ddbas471 ddbas601 ddbas602 ddbas603
+		ddbas604 ddbas605 ddbas606 ddbas607 ddbas608 ddbas609 ddbas610
+		ddbas612 ddbas614 ddbas615 ddbas616 ddbas617 ddbas618 ddbas619
+		ddbas620 ddbas621 ddbas622 ddbas630 ddbas631 ddbas633 ddbas634
+		ddbas635 ddbas636 ddbas637 ddbas638 ddbas639 ddbas640 ddbas642
+		ddbas643 ddbas644 ddbas645 ddbas646 ddbas647 ddbas648 ddbas649
+		ddbas651 ddbas652 ddbas653 ddbas654 ddbas655 ddbas656 ddbas657
+		ddbas658 ddbas659 ddbas660 ddbas661 ddbas662 ddbas663 ddbas664
+		ddbas665 ddbas666 ddbas667 ddbas668 ddbas669 ddbas670 ddbas671
+		ddbas672 ddbas673 ddbas674 ddbas675 ddbas676 ddbas677 ddbas678
+		ddbas679 ddbas908 ddbas909 ddbas911 ddbas913 ddbas915 ddbas916
+		ddbas920 ddbas921 ddbas923 ddbas925 ddbas927 ddbas928 ddbas1007
+		ddbas1008 ddbas1009 ddbas1010 ddbas1011 ddbas1012 ddbas1071 ddbas1072
+		ddbas1073 ddbas1075 ddbas1076 ddbas1077 ddbas1078 ddbas1102 ddbas1103
+		ddbas1106 ddbas1107
+		ddbast800 ddbast801 ddbast802 ddbast803 ddbast804 ddbast805 ddbast806
+		ddbast807 ddbast808 ddbast809 ddbast810 ddbast812 ddbast814 ddbast815
+		ddbast816 ddbast817 ddbast818 ddbast819 ddbast820 ddbast821 ddbast822
+		ddbast830 ddbast831 ddbast833 ddbast834 ddbast835 ddbast836 ddbast837
+		ddbast838 ddbast839 ddbast840 ddbast842 ddbast843 ddbast844 ddbast845
+		ddbast846 ddbast847 ddbast848 ddbast849 ddbast851 ddbast852 ddbast853
+		ddbast854 ddbast855 ddbast856 ddbast857 ddbast858 ddbast859 ddbast860
+		ddbast861 ddbast862 ddbast863 ddbast864 ddbast865 ddbast866 ddbast867
+		ddbast868 ddbast869 ddbast870 ddbast871 ddbast872 ddbast873 ddbast874
+		ddbast875 ddbast876 ddbast877 ddbast878 ddbast879
+		ddbem400 ddbem402 ddbem403 ddbem404 ddbem420 ddbem421 ddbem422
+		ddbem423 ddbem424 ddbem425 ddbem426 ddbem432 ddbem433 ddbem440
+		ddbem441 ddbem456 ddbem457 ddbem465 ddbem471 ddbem472 ddbem473
+		ddbem474 ddbem476 ddbem477 ddbem478
+		ddcps001 ddcps105 ddcps106 ddcps107 ddcps108 ddcps113 ddcps114
+		ddcps115 ddcps116 ddcps117 ddcps118 ddcps133 ddcps136 ddcps305
+		ddcps306 ddcps307 ddcps308 ddcps313 ddcps314 ddcps315 ddcps316
+		ddcps317 ddcps318 ddcps333 ddcps336
+		dddiv014 dddiv015 dddiv017 dddiv091 dddiv092 dddiv093 dddiv094
+		dddiv095 dddiv096 dddiv097 dddiv098 dddiv099 dddiv120 dddiv121
+		dddiv273 dddiv274 dddiv275 dddiv276 dddiv277 dddiv278 dddiv285
+		dddiv287 dddiv289 dddiv302 dddiv303 dddiv304 dddiv305 dddiv306
+		dddiv307 dddiv308 dddiv309 dddiv311 dddiv312 dddiv313 dddiv314
+		dddiv315 dddiv316 dddiv318 dddiv319 dddiv320 dddiv321 dddiv322
+		dddiv331 dddiv332 dddiv333 dddiv334 dddiv335 dddiv337 dddiv338
+		dddiv339 dddiv340 dddiv342 dddiv343 dddiv344 dddiv345 dddiv346
+		dddiv452 dddiv453 dddiv454 dddiv455 dddiv456 dddiv457 dddiv458
+		dddiv462 dddiv464 dddiv468 dddiv484 dddiv485 dddiv486 dddiv487
+		dddiv488 dddiv489 dddiv491 dddiv492 dddiv493 dddiv494 dddiv495
+		dddiv496 dddiv497 dddiv498 dddiv500 dddiv501 dddiv502 dddiv513
+		dddiv514 dddiv515 dddiv516 dddiv517 dddiv518 dddiv530 dddiv531
+		dddiv540 dddiv541 dddiv547 dddiv548 dddiv600 dddiv601 dddiv602
+		dddiv603 dddiv604 dddiv605 dddiv606 dddiv607 dddiv731 dddiv751
+		dddiv752 dddiv753 dddiv754 dddiv761 dddiv762 dddiv763 dddiv764
+		dddiv788 dddiv790 dddiv791 dddiv792 dddiv793 dddiv794 dddiv808
+		dddiv810 dddiv811 dddiv812 dddiv813 dddiv814 dddiv1026 dddiv1032
+		dddiv1055 dddiv1056 dddiv1057 dddiv1058 dddiv1067 dddiv1068 dddiv1069
+		dddiv1070 dddiv1071 dddiv1072 dddiv1073 dddiv1101 dddiv1102 dddiv1103
+		dddiv1104 dddiv1119 dddiv1120 dddiv1125 dddiv1126 dddiv1130 dddiv1131
+		dddiv1133 dddiv1134 dddiv1135 dddiv1136 dddiv1137 dddiv2031 dddiv2032
+		dddiv3044 dddiv3064 dddiv4003 dddiv4021 dddiv4026 dddiv4030 dddiv4037
+		dddiv4050 dddiv4053 dddiv4056 dddiv4058 dddiv4085 dddiv4089
+		ddfma0101 ddfma0112 ddfma0201 ddfma0220 ddfma0257 ddfma2004 ddfma2005
+		ddfma2006 ddfma2007 ddfma2008 ddfma2009 ddfma2011 ddfma2012 ddfma2013
+		ddfma2015 ddfma2016 ddfma2017 ddfma2018 ddfma2019 ddfma2025 ddfma2026
+		ddfma2027 ddfma2028 ddfma2030 ddfma2031 ddfma2032 ddfma2033 ddfma2034
+		ddfma2035 ddfma2036 ddfma2037 ddfma2038 ddfma2039 ddfma2040 ddfma2041
+		ddfma2042 ddfma2043 ddfma2044 ddfma2045 ddfma2046 ddfma2047 ddfma2048
+		ddfma2050 ddfma2053 ddfma2060 ddfma2061 ddfma2062 ddfma2063 ddfma2064
+		ddfma2065 ddfma2066 ddfma2316 ddfma2317 ddfma2318 ddfma2319 ddfma2320
+		ddfma2321 ddfma2322 ddfma2323 ddfma2504 ddfma2505 ddfma2551 ddfma2552
+		ddfma2553 ddfma2554 ddfma2555 ddfma2556 ddfma2557 ddfma2558 ddfma2561
+		ddfma2562 ddfma2563 ddfma2564 ddfma2565 ddfma2566 ddfma2567 ddfma2568
+		ddfma2571 ddfma2572 ddfma2573 ddfma2574 ddfma2575 ddfma2576 ddfma2577
+		ddfma2578 ddfma2755 ddfma2756 ddfma2757 ddfma2758 ddfma2767 ddfma2768
+		ddfma2769 ddfma2770 ddfma2771 ddfma2772 ddfma2773 ddfma2801 ddfma2802
+		ddfma2803 ddfma2804 ddfma2819 ddfma2820 ddfma2825 ddfma2826 ddfma2830
+		ddfma2831 ddfma2833 ddfma2834 ddfma2835 ddfma2836 ddfma2837 ddfma2838
+		ddfma2839 ddfma2840 ddfma2890 ddfma2900 ddfma2901 ddfma2902 ddfma2903
+		ddfma2904 ddfma2908 ddfma3007 ddfma3008 ddfma3010 ddfma3011 ddfma3012
+		ddfma3033 ddfma3035 ddfma3036 ddfma3037 ddfma3040 ddfma3041 ddfma3042
+		ddfma3053 ddfma3055 ddfma3057 ddfma3072 ddfma3073 ddfma3074 ddfma3077
+		ddfma3078 ddfma3079 ddfma3108 ddfma3109 ddfma3110 ddfma3111 ddfma3133
+		ddfma3134 ddfma3135 ddfma3136 ddfma3137 ddfma3138 ddfma3139 ddfma3140
+		ddfma3141 ddfma3142 ddfma3143 ddfma3144 ddfma3146 ddfma3147 ddfma3148
+		ddfma3149 ddfma3150 ddfma3151 ddfma3155 ddfma3159 ddfma3160 ddfma3173
+		ddfma3321 ddfma3326 ddfma3331 ddfma3336 ddfma3342 ddfma3343 ddfma3346
+		ddfma3347 ddfma3351 ddfma3360 ddfma3361 ddfma3362 ddfma3363 ddfma3364
+		ddfma3404 ddfma3405 ddfma3406 ddfma3413 ddfma3414 ddfma3415 ddfma3416
+		ddfma3424 ddfma3425 ddfma3426 ddfma3433 ddfma3434 ddfma3435 ddfma3436
+		ddfma36445 ddfma36446 ddfma36454 ddfma36455 ddfma36456 ddfma36465
+		ddfma36466 ddfma36474 ddfma36475 ddfma36476 ddfma36485 ddfma36486
+		ddfma36494 ddfma36495 ddfma36496 ddfma36505 ddfma36506 ddfma36514
+		ddfma36515 ddfma36516 ddfma36525 ddfma36526 ddfma37701 ddfma37702
+		ddfma37703 ddfma37704 ddfma37710 ddfma37711 ddfma37713 ddfma37714
+		ddfma37715 ddfma37716 ddfma37717 ddfma37718 ddfma37720 ddfma37721
+		ddfma37723 ddfma37724 ddfma37725 ddfma37726 ddfma37727 ddfma37728
+		ddfma37729 ddfma37731 ddfma37751 ddfma37752 ddfma37753 ddfma37754
+		ddfma37755 ddfma37756 ddfma37757 ddfma37758 ddfma37761 ddfma37762
+		ddfma37763 ddfma37764 ddfma37765 ddfma37766 ddfma37767 ddfma37768
+		ddfma37771 ddfma37772 ddfma37773 ddfma37774 ddfma37775 ddfma37776
+		ddfma37777 ddfma37778 ddfma371300 ddfma371325 ddfma371326 ddfma371327
+		ddfma371328 ddfma371329 ddfma371330 ddfma371331 ddfma371332
+		ddfma371333 ddfma371334 ddfma371335 ddfma371336 ddfma371337
+		ddfma371338 ddfma371339 ddfma371365 ddfma371367 ddfma371368
+		ddfma371369 ddfma371370 ddfma371371 ddfma371372 ddfma371373
+		ddfma371374 ddfma371375 ddfma371376 ddfma371377 ddfma371378
+		ddfma371379 ddfma371380 ddfma371381 ddfma371382 ddfma371383
+		ddfma371384 ddfma371385 ddfma371386 ddfma371387 ddfma371388
+		ddfma371389 ddfma371390 ddfma371391 ddfma371392 ddfma371393
+		ddfma371394 ddfma371395 ddfma371396 ddfma371500 ddfma371501
+		ddfma371502 ddfma371503 ddfma371540 ddfma371541 ddfma371542
+		ddfma371543 ddfma375057
+		ddintx053 ddintx054 ddintx067 ddintx068 ddintx069 ddintx070 ddintx087
+		ddintx088 ddintx089 ddintx090 ddintx134 ddintx135 ddintx136 ddintx137
+		ddintx138 ddintx147 ddintx148 ddintx149 ddintx150 ddintx151 ddintx205
+		ddm2
+		ddmax036 ddmax037 ddmax039 ddmax040 ddmax041 ddmax043 ddmax044
+		ddmax045 ddmax050 ddmax051 ddmax052 ddmax053 ddmax054 ddmax055
+		ddmax056 ddmax057 ddmax058 ddmax059 ddmax060 ddmax061 ddmax062
+		ddmax063 ddmax064 ddmax065 ddmax404 ddmax406 ddmax407 ddmax409
+		ddmax410 ddmax411 ddmax412 ddmax415 ddmax417 ddmax418 ddmax420
+		ddmax434 ddmax436 ddmax437 ddmax439 ddmax440 ddmax441 ddmax442
+		ddmax445 ddmax447 ddmax448 ddmax450 ddmax460 ddmax461 ddmax463
+		ddmax464 ddmax471 ddmax472 ddmax473 ddmax474 ddmax510 ddmax512
+		ddmax513
+		ddmin032 ddmin033 ddmin038 ddmin040 ddmin041 ddmin042 ddmin044
+		ddmin045 ddmin046 ddmin047 ddmin048 ddmin049 ddmin050 ddmin051
+		ddmin282 ddmin283 ddmin403 ddmin405 ddmin406 ddmin408 ddmin410
+		ddmin411 ddmin412 ddmin413 ddmin416 ddmin417 ddmin419 ddmin433
+		ddmin435 ddmin436 ddmin438 ddmin440 ddmin441 ddmin442 ddmin443
+		ddmin446 ddmin447 ddmin449 ddmin462 ddmin465 ddmin466 ddmin467
+		ddmin475 ddmin476 ddmin477 ddmin478 ddmin479 ddmin480 ddmin481
+		ddmin482 ddmin483 ddmin484 ddmin530 ddmin532 ddmin533
+		ddmng032 ddmng033 ddmng038 ddmng040 ddmng041 ddmng042 ddmng044
+		ddmng045 ddmng046 ddmng047 ddmng048 ddmng049 ddmng050 ddmng051
+		ddmng282 ddmng283 ddmng403 ddmng405 ddmng406 ddmng408 ddmng410
+		ddmng411 ddmng412 ddmng413 ddmng417 ddmng433 ddmng435 ddmng436
+		ddmng438 ddmng440 ddmng441 ddmng442 ddmng443 ddmng447 ddmng462
+		ddmng465
+		ddmns001 ddmns105 ddmns106 ddmns107 ddmns108 ddmns113 ddmns114
+		ddmns115 ddmns116 ddmns117 ddmns118 ddmns133 ddmns136
+		ddmul004 ddmul005 ddmul006 ddmul007 ddmul008 ddmul009 ddmul011
+		ddmul012 ddmul013 ddmul015 ddmul016 ddmul017 ddmul018 ddmul019
+		ddmul025 ddmul026 ddmul027 ddmul028 ddmul030 ddmul031 ddmul032
+		ddmul033 ddmul034 ddmul035 ddmul036 ddmul037 ddmul038 ddmul039
+		ddmul040 ddmul041 ddmul042 ddmul043 ddmul044 ddmul045 ddmul050
+		ddmul053 ddmul060 ddmul061 ddmul062 ddmul063 ddmul064 ddmul065
+		ddmul066 ddmul316 ddmul317 ddmul318 ddmul319 ddmul320 ddmul321
+		ddmul322 ddmul323 ddmul504 ddmul505 ddmul506 ddmul551 ddmul552
+		ddmul553 ddmul554 ddmul555 ddmul556 ddmul557 ddmul558 ddmul561
+		ddmul562 ddmul563 ddmul564 ddmul565 ddmul566 ddmul567 ddmul568
+		ddmul571 ddmul572 ddmul573 ddmul574 ddmul575 ddmul576 ddmul577
+		ddmul578 ddmul755 ddmul756 ddmul757 ddmul758 ddmul767 ddmul768
+		ddmul769 ddmul770 ddmul771 ddmul772 ddmul773 ddmul801 ddmul802
+		ddmul803 ddmul804 ddmul819 ddmul820 ddmul825 ddmul826 ddmul830
+		ddmul831 ddmul833 ddmul834 ddmul835 ddmul836 ddmul837 ddmul838
+		ddmul839 ddmul840 ddmul890 ddmul900 ddmul901 ddmul902 ddmul903
+		ddmul904 ddmul906 ddmul912 ddmul913 ddmul914 ddmul1041 ddmul1061
+		ddmul1062 ddmul1081 ddmul1082 ddmul1083
+		ddmxg036 ddmxg037 ddmxg039 ddmxg040 ddmxg041 ddmxg043 ddmxg044
+		ddmxg045 ddmxg050 ddmxg051 ddmxg052 ddmxg053 ddmxg054 ddmxg055
+		ddmxg056 ddmxg057 ddmxg058 ddmxg059 ddmxg060 ddmxg061 ddmxg062
+		ddmxg063 ddmxg064 ddmxg065 ddmxg404 ddmxg406 ddmxg407 ddmxg409
+		ddmxg410 ddmxg411 ddmxg412 ddmxg415 ddmxg416 ddmxg417 ddmxg418
+		ddmxg419 ddmxg420 ddmxg434 ddmxg436 ddmxg437 ddmxg439 ddmxg440
+		ddmxg441 ddmxg442 ddmxg445 ddmxg446 ddmxg447 ddmxg448 ddmxg449
+		ddmxg450 ddmxg460 ddmxg461 ddmxg463 ddmxg464 ddmxg510 ddmxg512
+		ddmxg513 ddmxg530 ddmxg532 ddmxg533
+		ddnextm009 ddnextm019 ddnextm025 ddnextm037 ddnextm062 ddnextm181
+		ddnextp005 ddnextp017 ddnextp029 ddnextp039 ddnextp181
+		ddpls001 ddpls105 ddpls106 ddpls107 ddpls108 ddpls113 ddpls114
+		ddpls115 ddpls116 ddpls117 ddpls118 ddpls133 ddpls136
+		ddqua003 ddqua005 ddqua008 ddqua009 ddqua010 ddqua011 ddqua014
+		ddqua015 ddqua023 ddqua025 ddqua028 ddqua029 ddqua030 ddqua031
+		ddqua034 ddqua035 ddqua036 ddqua037 ddqua040 ddqua041 ddqua042
+		ddqua043 ddqua046 ddqua047 ddqua060 ddqua064 ddqua068 ddqua071
+		ddqua073 ddqua074 ddqua089 ddqua090 ddqua091 ddqua092 ddqua095
+		ddqua096 ddqua097 ddqua098 ddqua100 ddqua102 ddqua103 ddqua105
+		ddqua106 ddqua108 ddqua109 ddqua111 ddqua112 ddqua120 ddqua122
+		ddqua124 ddqua126 ddqua132 ddqua140 ddqua141 ddqua142 ddqua144
+		ddqua145 ddqua147 ddqua148 ddqua150 ddqua151 ddqua152 ddqua163
+		ddqua188 ddqua189 ddqua190 ddqua191 ddqua202 ddqua203 ddqua205
+		ddqua206 ddqua208 ddqua209 ddqua220 ddqua221 ddqua222 ddqua224
+		ddqua225 ddqua226 ddqua228 ddqua229 ddqua230 ddqua232 ddqua233
+		ddqua234 ddqua240 ddqua241 ddqua242 ddqua243 ddqua244 ddqua245
+		ddqua246 ddqua247 ddqua248 ddqua249 ddqua250 ddqua251 ddqua253
+		ddqua254 ddqua255 ddqua256 ddqua257 ddqua258 ddqua259 ddqua260
+		ddqua261 ddqua262 ddqua263 ddqua264 ddqua265 ddqua266 ddqua267
+		ddqua268 ddqua269 ddqua270 ddqua271 ddqua272 ddqua273 ddqua274
+		ddqua275 ddqua280 ddqua281 ddqua282 ddqua283 ddqua284 ddqua285
+		ddqua286 ddqua287 ddqua288 ddqua289 ddqua290 ddqua291 ddqua292
+		ddqua293 ddqua294 ddqua295 ddqua300 ddqua304 ddqua306 ddqua307
+		ddqua310 ddqua314 ddqua316 ddqua317 ddqua320 ddqua321 ddqua326
+		ddqua327 ddqua330 ddqua331 ddqua336 ddqua337 ddqua340 ddqua341
+		ddqua342 ddqua346 ddqua347 ddqua350 ddqua351 ddqua352 ddqua356
+		ddqua357 ddqua360 ddqua361 ddqua362 ddqua363 ddqua366 ddqua367
+		ddqua368 ddqua370 ddqua371 ddqua372 ddqua373 ddqua376 ddqua377
+		ddqua378 ddqua400 ddqua401 ddqua403 ddqua404 ddqua406 ddqua407
+		ddqua410 ddqua411 ddqua413 ddqua414 ddqua416 ddqua420 ddqua422
+		ddqua423 ddqua426 ddqua431 ddqua432 ddqua434 ddqua436 ddqua440
+		ddqua441 ddqua441#01 ddqua443 ddqua444 ddqua446 ddqua481 ddqua482
+		ddqua483 ddqua484 ddqua485 ddqua486 ddqua491 ddqua492 ddqua493
+		ddqua494 ddqua495 ddqua496 ddqua500 ddqua502 ddqua503 ddqua505
+		ddqua506 ddqua508 ddqua509 ddqua511 ddqua512 ddqua514 ddqua515
+		ddqua517 ddqua520 ddqua521 ddqua522 ddqua523 ddqua533 ddqua534
+		ddqua537 ddqua718 ddqua719 ddqua720 ddqua731 ddqua732 ddqua733
+		ddqua734 ddqua736 ddqua737 ddqua738 ddqua739 ddqua740 ddqua744
+		ddqua745 ddqua747 ddqua748 ddqua749 ddqua750 ddqua751 ddqua752
+		ddqua753 ddqua755 ddqua756 ddqua758 ddqua759 ddqua760 ddqua761
+		ddqua762 ddqua763 ddqua764 ddqua769 ddqua1001 ddqua1029
+		ddscb001 ddscb002 ddscb004 ddscb005 ddscb006 ddscb007 ddscb008
+		ddscb009 ddscb011 ddscb012 ddscb013 ddscb055 ddscb056 ddscb057
+		ddscb058 ddscb065 ddscb066 ddscb067 ddscb068 ddscb075 ddscb076
+		ddscb077 ddscb078 ddscb081 ddscb082 ddscb083 ddscb084 ddscb085
+		ddscb086 ddscb087 ddscb088 ddscb091 ddscb092 ddscb093 ddscb094
+		ddscb097 ddscb098 ddscb111 ddscb112 ddscb113 ddscb114 ddscb115
+		ddscb116 ddscb117 ddscb118 ddscb124 ddscb125 ddscb140 ddscb141
+		ddscb142 ddscb145 ddscb152 ddscb153 ddscb154 ddscb155 ddscb171
+		ddscb172 ddscb173 ddscb174 ddscb175 ddscb176 ddscb177 ddscb178
+		ddscb179 ddscb180 ddscb181 ddscb182 ddscb183 ddscb184 ddscb185
+		ddscb186 ddscb201 ddscb202 ddscb203 ddscb204 ddscb205 ddscb206
+		ddscb207 ddscb208 ddscb209 ddscb210 ddscb211 ddscb212 ddscb213
+		ddscb214 ddscb215 ddscb217 ddscb218
+		ddsub045 ddsub046 ddsub051 ddsub060 ddsub061 ddsub064 ddsub065
+		ddsub066 ddsub069 ddsub090 ddsub091 ddsub092 ddsub093 ddsub094
+		ddsub098 ddsub105 ddsub106 ddsub107 ddsub108 ddsub125 ddsub136
+		ddsub327 ddsub365 ddsub366 ddsub372 ddsub373 ddsub376 ddsub377
+		ddsub408 ddsub409 ddsub410 ddsub411 ddsub438 ddsub439 ddsub440
+		ddsub441 ddsub472 ddsub473 ddsub474 ddsub475 ddsub476 ddsub477
+		ddsub921 ddsub925
+		sqtx003 sqtx006 sqtx007 sqtx008 sqtx009 sqtx010 sqtx017 sqtx018
+		sqtx019 sqtx020 sqtx021 sqtx022 sqtx023 sqtx024 sqtx026 sqtx027
+		sqtx028 sqtx061 sqtx062 sqtx068 sqtx069 sqtx078 sqtx079 sqtx109
+		sqtx704 sqtx801 sqtx805 sqtx809 sqtx1207 sqtx1231 sqtx1271 sqtx2204
+		sqtx2207 sqtx2228 sqtx2231 sqtx2268 sqtx2271 sqtx2274 sqtx2275
+		sqtx2277 sqtx2280 sqtx2324 sqtx2327 sqtx2396 sqtx2399 sqtx2484
+		sqtx2487 sqtx2514 sqtx2515 sqtx2517 sqtx2520 sqtx2588 sqtx2591
+		sqtx2708 sqtx2711 sqtx2844 sqtx2847 sqtx2914 sqtx2915 sqtx2917
+		sqtx2920 sqtx8010 sqtx8112 sqtx8137 sqtx8139 sqtx8141 sqtx8143
+		sqtx8145 sqtx8146 sqtx8147 sqtx8149 sqtx8150 sqtx8151 sqtx8157
+		sqtx8158 sqtx8163 sqtx8165 sqtx8168 sqtx8171 sqtx8174 sqtx8175
+		sqtx8176 sqtx8177 sqtx8178 sqtx8179 sqtx8180 sqtx8181 sqtx8182
+		sqtx8183 sqtx8184 sqtx8186 sqtx8187 sqtx8188 sqtx8189 sqtx8190
+		sqtx8191 sqtx8192 sqtx8193 sqtx8194 sqtx8197 sqtx8198 sqtx8199
+		sqtx8200 sqtx8201 sqtx8202 sqtx8203 sqtx8204 sqtx8208 sqtx8212
+		sqtx8213 sqtx8214 sqtx8218 sqtx8219 sqtx8523 sqtx8524 sqtx8525
+		sqtx8526 sqtx8531 sqtx8532 sqtx8533 sqtx8539 sqtx8540 sqtx8541
+		sqtx8542 sqtx8543 sqtx8544 sqtx8626 sqtx8631 sqtx8632 sqtx8633
+		sqtx8634 sqtx8636 sqtx8637 sqtx8639 sqtx8640 sqtx8641 sqtx8643
+		sqtx8644 sqtx8645 sqtx8646 sqtx8647 sqtx8648 sqtx8649 sqtx8650
+		sqtx8651 sqtx8652 sqtx8654 sqtx9010 sqtx9011 sqtx9012 sqtx9013
+		sqtx9014 sqtx9015 sqtx9020 sqtx9021 sqtx9022 sqtx9023 sqtx9024
+		sqtx9025 sqtx9026 sqtx9027 sqtx9030 sqtx9031 sqtx9032 sqtx9033
+		sqtx9034 sqtx9035 sqtx9036 sqtx9037 sqtx9038 sqtx9039 sqtx9040
+		sqtx9045 sqtx9047 sqtx9049
+	`,
+	unknownKeyword: `
+		ddbas702 ddbas703 ddbas706 ddbas707 ddbas710 ddbas711 ddbas732
+		ddbas733 ddbas736 ddbas737 ddbas740 ddbas741 ddbas750 ddbas751
+		ddbas754 ddbas755 ddbas758 ddbas759 ddbas762 ddbas763 ddbas766
+		ddbas767 ddbas770 ddbas771
+		ddbast780 ddbast781 ddbast782 ddbast786 ddbast787 ddbast788 ddbast789
+		ddbast790 ddbast791
+	`,
+	extraKeyword: `
+		ddbas561
+	`,
+})
+
+func knownFailureSet(cases map[knownFailure]string) map[string]knownFailure {
+	set := map[string]knownFailure{}
+	for known, names := range cases {
+		for _, name := range strings.Fields(names) {
+			set[name] = known
+		}
+	}
+	return set
+}
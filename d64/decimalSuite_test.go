@@ -2,9 +2,11 @@ package d64
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -22,7 +24,9 @@ type testCase struct {
 	val2           string
 	val3           string
 	expectedResult string
+	conditions     []string
 	rounding       string
+	operands       int
 }
 
 func (testVal *testCase) String() string {
@@ -57,7 +61,11 @@ func TestFromSuite(t *testing.T) {
 		return func(t *testing.T) {
 			t.Parallel()
 
-			f, _ := os.Open(file)
+			f, err := os.Open(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
 			scanner := bufio.NewScanner(f)
 			numTests := 0
 			var roundingSupported bool
@@ -76,8 +84,26 @@ func TestFromSuite(t *testing.T) {
 				if testVal.function != "" && roundingSupported {
 					numTests++
 					t.Run(testVal.name, func(t *testing.T) {
-						decvals, err := convertToDec(testVal)
-						isnil(t, err)
+						decvals, err := convertToDec(scannedContext, testVal)
+						switch known := knownFailures[testVal.name]; {
+						case known == unknownKeyword:
+							check(t, errors.Is(err, ErrSyntax)).Or(func() {
+								t.Errorf("test:\n%s\nParse accepts %q, so remove it from knownFailures", testVal, testVal.val1)
+							})
+							return
+						case slices.Contains(testVal.conditions, "Conversion_syntax"):
+							if known == extraKeyword {
+								isnil(t, err)
+								return
+							}
+							check(t, errors.Is(err, ErrSyntax)).Or(func() {
+								t.Errorf("test:\n%s\nexpected syntax error, got %v", testVal, err)
+							})
+							return
+						}
+						if !isnil(t, err) {
+							return
+						}
 						if !runTest(t, scannedContext, decvals, testVal) {
 							runTest(t, scannedContext, decvals, testVal)
 						}
@@ -87,63 +113,63 @@ func TestFromSuite(t *testing.T) {
 		}
 	}
 
-	t.Run("ddAbs", test("dectest/ddAbs.decTest"))
-	t.Run("ddAdd", test("dectest/ddAdd.decTest"))
-	t.Run("ddClass", test("dectest/ddClass.decTest"))
-	t.Run("ddCompare", test("dectest/ddCompare.decTest"))
-	t.Run("ddCopySign", test("dectest/ddCopySign.decTest"))
-	t.Run("ddDivide", test("dectest/ddDivide.decTest"))
-	t.Run("ddFMA", test("dectest/ddFMA.decTest"))
-	t.Run("ddLogB", test("dectest/ddLogB.decTest"))
-	t.Run("ddMax", test("dectest/ddMax.decTest"))
-	t.Run("ddMaxMag", test("dectest/ddMaxMag.decTest"))
-	t.Run("ddMin", test("dectest/ddMin.decTest"))
-	t.Run("ddMinMag", test("dectest/ddMinMag.decTest"))
-	t.Run("ddMinus", test("dectest/ddMinus.decTest"))
-	t.Run("ddMultiply", test("dectest/ddMultiply.decTest"))
-	t.Run("ddNextMinus", test("dectest/ddNextMinus.decTest"))
-	t.Run("ddNextPlus", test("dectest/ddNextPlus.decTest"))
-	t.Run("ddPlus", test("dectest/ddPlus.decTest"))
-	t.Run("ddRound", test("dectest/ddRound.decTest"))
-	t.Run("ddScaleB", test("dectest/ddScaleB.decTest"))
-	t.Run("ddSubtract", test("dectest/ddSubtract.decTest"))
-	t.Run("ddToIntegral", test("dectest/ddToIntegral.decTest"))
-	t.Run("squareroot", test("dectest/squareroot.decTest"))
+	t.Run("ddAbs", test("../dectest/ddAbs.decTest"))
+	t.Run("ddAdd", test("../dectest/ddAdd.decTest"))
+	t.Run("ddBase", test("../dectest/ddBase.decTest"))
+	t.Run("ddClass", test("../dectest/ddClass.decTest"))
+	t.Run("ddCompare", test("../dectest/ddCompare.decTest"))
+	t.Run("ddCopySign", test("../dectest/ddCopySign.decTest"))
+	t.Run("ddDivide", test("../dectest/ddDivide.decTest"))
+	t.Run("ddFMA", test("../dectest/ddFMA.decTest"))
+	t.Run("ddLogB", test("../dectest/ddLogB.decTest"))
+	t.Run("ddMax", test("../dectest/ddMax.decTest"))
+	t.Run("ddMaxMag", test("../dectest/ddMaxMag.decTest"))
+	t.Run("ddMin", test("../dectest/ddMin.decTest"))
+	t.Run("ddMinMag", test("../dectest/ddMinMag.decTest"))
+	t.Run("ddMinus", test("../dectest/ddMinus.decTest"))
+	t.Run("ddMultiply", test("../dectest/ddMultiply.decTest"))
+	t.Run("ddNextMinus", test("../dectest/ddNextMinus.decTest"))
+	t.Run("ddNextPlus", test("../dectest/ddNextPlus.decTest"))
+	t.Run("ddPlus", test("../dectest/ddPlus.decTest"))
+	t.Run("ddRound", test("../dectest/ddRound.decTest"))
+	t.Run("ddScaleB", test("../dectest/ddScaleB.decTest"))
+	t.Run("ddSubtract", test("../dectest/ddSubtract.decTest"))
+	t.Run("ddToIntegral", test("../dectest/ddToIntegral.decTest"))
+	t.Run("squareroot", test("../dectest/squareroot.decTest"))
 
 	// Future
-	// t.Run("ddBase", test("dectest/ddBase.decTest"))
-	// t.Run("ddCompareTotal", test("dectest/ddCompareTotal.decTest"))
-	// t.Run("ddCompareTotalMag", test("dectest/ddCompareTotalMag.decTest"))
-	// t.Run("ddCopyAbs.decTest", //", test("dectest/ddCopyAbs.decTest", // QAb)s)
-	// t.Run("ddCopyNegate.decTest", //", test("dectest/ddCopyNegate.decTest", // QNe)g)
-	// t.Run("ddDivideInt", test("dectest/ddDivideInt.decTest"))
-	// t.Run("ddNextToward", test("dectest/ddNextToward.decTest"))
-	// t.Run("ddRemainder", test("dectest/ddRemainder.decTest"))
-	// t.Run("ddRemainderNear", test("dectest/ddRemainderNear.decTest"))
+	// t.Run("ddCompareTotal", test("../dectest/ddCompareTotal.decTest"))
+	// t.Run("ddCompareTotalMag", test("../dectest/ddCompareTotalMag.decTest"))
+	// t.Run("ddCopyAbs.decTest", //", test("../dectest/ddCopyAbs.decTest", // QAb)s)
+	// t.Run("ddCopyNegate.decTest", //", test("../dectest/ddCopyNegate.decTest", // QNe)g)
+	// t.Run("ddDivideInt", test("../dectest/ddDivideInt.decTest"))
+	// t.Run("ddNextToward", test("../dectest/ddNextToward.decTest"))
+	// t.Run("ddRemainder", test("../dectest/ddRemainder.decTest"))
+	// t.Run("ddRemainderNear", test("../dectest/ddRemainderNear.decTest"))
 
 	// Wat?
-	// t.Run("ddEncode", test("dectest/ddEncode.decTest"))
+	// t.Run("ddEncode", test("../dectest/ddEncode.decTest"))
 
 	// Not planned
 	// -- bitwise
-	// t.Run("ddAnd", test("dectest/ddAnd.decTest"))
-	// t.Run("ddInvert", test("dectest/ddInvert.decTest"))
-	// t.Run("ddOr", test("dectest/ddOr.decTest"))
-	// t.Run("ddRotate", test("dectest/ddRotate.decTest"))
-	// t.Run("ddShift", test("dectest/ddShift.decTest"))
-	// t.Run("ddXor", test("dectest/ddXor.decTest"))
+	// t.Run("ddAnd", test("../dectest/ddAnd.decTest"))
+	// t.Run("ddInvert", test("../dectest/ddInvert.decTest"))
+	// t.Run("ddOr", test("../dectest/ddOr.decTest"))
+	// t.Run("ddRotate", test("../dectest/ddRotate.decTest"))
+	// t.Run("ddShift", test("../dectest/ddShift.decTest"))
+	// t.Run("ddXor", test("../dectest/ddXor.decTest"))
 	//
 	// -- signalling
-	// t.Run("ddCompareSig", test("dectest/ddCompareSig.decTest"))
+	// t.Run("ddCompareSig", test("../dectest/ddCompareSig.decTest"))
 	//
 	// -- nop
-	// t.Run("ddCopy", test("dectest/ddCopy.decTest"))
+	// t.Run("ddCopy", test("../dectest/ddCopy.decTest"))
 	//
 	// -- repr
-	// t.Run("ddCanonical", test("dectest/ddCanonical.decTest"))
-	// t.Run("ddQuantize", test("dectest/ddQuantize.decTest"))
-	// t.Run("ddReduce", test("dectest/ddReduce.decTest"))
-	// t.Run("ddSameQuantum", test("dectest/ddSameQuantum.decTest"))
+	// t.Run("ddCanonical", test("../dectest/ddCanonical.decTest"))
+	// t.Run("ddQuantize", test("../dectest/ddQuantize.decTest"))
+	// t.Run("ddReduce", test("../dectest/ddReduce.decTest"))
+	// t.Run("ddSameQuantum", test("../dectest/ddSameQuantum.decTest"))
 
 }
 
@@ -180,9 +206,10 @@ func getInput(line string) *testCase {
 	}
 	fields := make([]string, 0, len(m))
 	for _, f := range m {
-		fields = append(fields, strings.ReplaceAll(f[1], "''", "'")+f[2])
+		fields = append(fields, strings.ReplaceAll(f[1], "''", "'")+strings.Trim(f[2], `"`))
 	}
 	i := 0
+	operands := 3
 	for ; i < len(fields); i++ {
 		if fields[i] == "->" {
 			break
@@ -196,6 +223,7 @@ func getInput(line string) *testCase {
 			panic(fmt.Errorf("malformed input: %s", line))
 		}
 		head, tail := fields[:i], fields[i:]
+		operands = i - 2
 		for ; i < 5; i++ {
 			head = append(append([]string{}, head...), "")
 		}
@@ -203,11 +231,13 @@ func getInput(line string) *testCase {
 	}
 	test := &testCase{
 		name:           fields[0],
-		function:       fields[1],
+		function:       strings.ToLower(fields[1]),
 		val1:           fields[2],
 		val2:           fields[3],
 		val3:           fields[4],
 		expectedResult: fields[6], // field[6] == "->"
+		conditions:     fields[7:],
+		operands:       operands,
 	}
 	if excludedTests.Has(test.name) {
 		return nil
@@ -225,13 +255,10 @@ func getInput(line string) *testCase {
 }
 
 // convertToDec converts the map object strings to decimals.
-func convertToDec(testvals *testCase) (opResult, error) {
+func convertToDec(ctx Context, testvals *testCase) (opResult, error) {
 	var r opResult
 	var err error
-	parseNotEmpty := func(s string) (Decimal, error) {
-		if s == "" {
-			return QNaN, nil
-		}
+	parse := func(s string) (Decimal, error) {
 		if hexBits, cut := strings.CutPrefix(s, "#"); cut {
 			bits, err := strconv.ParseUint(hexBits, 16, 64)
 			if err != nil {
@@ -239,24 +266,26 @@ func convertToDec(testvals *testCase) (opResult, error) {
 			}
 			return newDec(bits), nil
 		}
-		return Parse(s)
+		return ctx.Parse(s)
 	}
-	r.val1, err = parseNotEmpty(testvals.val1)
-	if err != nil {
-		return opResult{}, fmt.Errorf("error parsing val1: %w", err)
-	}
-	r.val2, err = parseNotEmpty(testvals.val2)
-	if err != nil {
-		return opResult{}, fmt.Errorf("error parsing val2: %w", err)
-	}
-	r.val3, err = parseNotEmpty(testvals.val3)
-	if err != nil {
-		return opResult{}, fmt.Errorf("error parsing val3: %w", err)
+	// An explicit '' operand is a syntax error, but a missing one is NaN, so
+	// operations given too few operands give NaN.
+	vals := []*Decimal{&r.val1, &r.val2, &r.val3}
+	texts := []string{testvals.val1, testvals.val2, testvals.val3}
+	for i, val := range vals {
+		if i >= testvals.operands {
+			*val = QNaN
+			continue
+		}
+		*val, err = parse(texts[i])
+		if err != nil {
+			return opResult{}, fmt.Errorf("error parsing val%d: %w", i+1, err)
+		}
 	}
 	if textResults.Has(testvals.function) {
 		r.text = testvals.expectedResult
 	} else {
-		r.result, err = parseNotEmpty(testvals.expectedResult)
+		r.result, err = parse(testvals.expectedResult)
 		if err != nil {
 			return opResult{}, fmt.Errorf("error parsing expected: %w", err)
 		}
@@ -268,31 +297,36 @@ func convertToDec(testvals *testCase) (opResult, error) {
 func runTest(t *testing.T, context Context, expected opResult, testValStrings *testCase) pass {
 	return replayOnFail(t, func() {
 		actual := execOp(context, expected.val1, expected.val2, expected.val3, testValStrings.function)
-		switch {
-		case actual.text != "":
-			if testValStrings.function == "compare" && actual.text == "-2" && expected.result.IsNaN() {
-				return
-			}
-			if actual.text != testValStrings.expectedResult {
-				t.Errorf("test:\n%s\ncalculated text: %s", testValStrings, actual.text)
-			}
-		case actual.result.IsNaN() || expected.result.IsNaN():
-			e := expected.result.String()
-			a := actual.result.String()
-			if e != a {
-				t.Errorf("test:\n%s\ncalculated result: %v", testValStrings, actual.result)
+		want, got := testValStrings.expectedResult, actual.text
+		if !textResults.Has(testValStrings.function) {
+			got = actual.result.SciString()
+		}
+		if knownFailures[testValStrings.name] != lostExponent {
+			if got != want {
+				t.Errorf("test:\n%s\ncalculated: %s", testValStrings, got)
 			}
-		case expected.result.Cmp(actual.result) != 0:
-			t.Errorf("test:\n%s\ncalculated result: %v", testValStrings, actual.result)
+			return
+		}
+		if got == want {
+			t.Errorf("test:\n%s\ncalculated: %s, so remove it from knownFailures", testValStrings, got)
+			return
+		}
+		// The result must still have the expected value.
+		value := expected.result.SciString()
+		if textResults.Has(testValStrings.function) {
+			value = execOp(context, context.MustParse(want), Decimal{}, Decimal{}, testValStrings.function).text
+		}
+		if got != value {
+			t.Errorf("test:\n%s\ncalculated: %s rather than %s", testValStrings, got, value)
 		}
 	})
 }
 
-var textResults = set{"class": {}}
+var textResults = set{"class": {}, "tosci": {}, "toeng": {}}
 
 var ops = map[string]func(ctx Context, a, b, c Decimal) any{
 	"add":         func(ctx Context, a, b, c Decimal) any { return ctx.Add(a, b) },
-	"abs":         func(ctx Context, a, b, c Decimal) any { return a.Abs() },
+	"abs":         func(ctx Context, a, b, c Decimal) any { return gdaAbs(ctx, a) },
 	"class":       func(ctx Context, a, b, c Decimal) any { return a.Class() },
 	"compare":     func(ctx Context, a, b, c Decimal) any { return a.CmpDec(b) },
 	"copysign":    func(ctx Context, a, b, c Decimal) any { return a.CopySign(b) },
@@ -303,19 +337,31 @@ var ops = map[string]func(ctx Context, a, b, c Decimal) any{
 	"maxmag":      func(ctx Context, a, b, c Decimal) any { return a.MaxMag(b) },
 	"min":         func(ctx Context, a, b, c Decimal) any { return a.Min(b) },
 	"minmag":      func(ctx Context, a, b, c Decimal) any { return a.MinMag(b) },
-	"minus":       func(ctx Context, a, b, c Decimal) any { return a.Neg() },
+	"minus":       func(ctx Context, a, b, c Decimal) any { return ctx.Sub(Zero, a) },
 	"multiply":    func(ctx Context, a, b, c Decimal) any { return ctx.Mul(a, b) },
 	"nextminus":   func(ctx Context, a, b, c Decimal) any { return a.NextMinus() },
 	"nextplus":    func(ctx Context, a, b, c Decimal) any { return a.NextPlus() },
-	"plus":        func(ctx Context, a, b, c Decimal) any { return a },
+	"plus":        func(ctx Context, a, b, c Decimal) any { return ctx.Add(Zero, a) },
 	"scaleb":      func(ctx Context, a, b, c Decimal) any { return a.ScaleB(b) },
 	"round":       func(ctx Context, a, b, c Decimal) any { return ctx.Round(a, b) },
 	"tointegralx": func(ctx Context, a, b, c Decimal) any { return ctx.ToIntegral(a) },
-	"subtract":    func(ctx Context, a, b, c Decimal) any { return ctx.Add(a, b.Neg()) },
+	"tosci":       func(ctx Context, a, b, c Decimal) any { return a.SciString() },
+	"toeng":       func(ctx Context, a, b, c Decimal) any { return a.EngString() },
+	"subtract":    func(ctx Context, a, b, c Decimal) any { return ctx.Sub(a, b) },
 	"squareroot":  func(ctx Context, a, b, c Decimal) any { return a.Sqrt() },
 	// "quantize":    func(ctx Context, a, b, c Decimal) any { return ctx.Quantize(a, b) },
 }
 
+// gdaAbs computes abs as the General Decimal Arithmetic specification defines
+// it: minus for negative operands, plus otherwise. Unlike [Decimal.Abs], this
+// quiets signalling NaNs.
+func gdaAbs(ctx Context, a Decimal) Decimal {
+	if a.Signbit() {
+		return ctx.Sub(Zero, a)
+	}
+	return ctx.Add(Zero, a)
+}
+
 // TODO: get runTest to run more functions such as FMA.
 // execOp returns the calculated answer to the operation as [Decimal].
 func execOp(ctx Context, a, b, c Decimal, op string) opResult {
@@ -366,3 +366,30 @@ func TestIsSubnormal(t *testing.T) {
 	check(t, !MustParse("NaN10").IsSubnormal())
 	check(t, !NewFromInt64(42).IsSubnormal())
 }
+
+func TestNewFromCoefficient(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, expectedErr error, ctx Context, exp int, c uint128T, sticky bool) {
+		t.Helper()
+		d, err := ctx.newFromCoefficient(0, exp, c, sticky)
+		equal(t, expectedErr, err)
+		equalD64(t, MustParse(expected), d)
+	}
+
+	var c uint128T
+	c.umul64(12345678901234567, 1000) // 12345678901234567000
+
+	test("0", nil, DefaultContext, 5, uint128T{}, false)
+	test("123", nil, DefaultContext, 0, uint128T{123, 0}, false)
+	test("1.234567890123457e19", ErrInexact, DefaultContext, 0, c, false)
+	test("1.234567890123456e19", ErrInexact, Context{Rounding: Down}, 0, c, false)
+	test("1.25e-396", nil, DefaultContext, -398, uint128T{125, 0}, false)
+	test("1.3e-397", ErrInexact, DefaultContext, -400, uint128T{1250, 0}, false)
+	test("1.2e-397", ErrInexact, Context{Rounding: HalfEven}, -400, uint128T{1250, 0}, false)
+	test("1.3e-397", ErrInexact, Context{Rounding: HalfEven}, -400, uint128T{1250, 0}, true)
+	test("0", ErrInexact, DefaultContext, -500, uint128T{1, 0}, false)
+	test("inf", ErrRange, DefaultContext, 385, uint128T{1, 0}, false)
+	test("9.999999999999999e384", nil, DefaultContext, 369, uint128T{maxSig, 0}, false)
+	test("inf", ErrRange, DefaultContext, 369, uint128T{99999999999999999, 0}, false)
+}
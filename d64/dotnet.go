@@ -0,0 +1,91 @@
+package d64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Layout of the flags word of a .NET System.Decimal.
+const (
+	dotNetSignBit    = 1 << 31
+	dotNetScaleShift = 16
+	dotNetScaleMask  = 0xff << dotNetScaleShift
+	dotNetMaxScale   = 28
+)
+
+// AppendDotNetDecimal appends d to buf as a 16-byte .NET System.Decimal,
+// as written by BinaryWriter.Write(decimal). It uses [DefaultContext].
+// See [Context.AppendDotNetDecimal].
+func (d Decimal) AppendDotNetDecimal(buf []byte) ([]byte, error) {
+	return DefaultContext.AppendDotNetDecimal(buf, d)
+}
+
+// AppendDotNetDecimal appends d to buf as a 16-byte .NET System.Decimal,
+// as written by BinaryWriter.Write(decimal). The layout is the four
+// little-endian 32-bit words returned by decimal.GetBits: the low, middle
+// and high words of a 96-bit integer, followed by a flags word holding a
+// scale of 0 to 28 and the sign.
+//
+// Numbers with nonzero digits below 10⁻²⁸ are rounded with ctx, and the
+// rounded value is appended along with [ErrInexact]. AppendDotNetDecimal
+// reports an error wrapping [ErrRange] if d is too large for System.Decimal,
+// and an error for NaN and ±∞, leaving buf unchanged.
+func (ctx Context) AppendDotNetDecimal(buf []byte, d Decimal) ([]byte, error) {
+	if !d.isFinite() {
+		return buf, fmt.Errorf("%v not valid as System.Decimal", d)
+	}
+	var dp decParts
+	dp.unpack(d)
+	dp.trimZeros()
+	scale := max(0, min(-int(dp.exp), dotNetMaxScale))
+
+	neg, u, err := ctx.unscaled(d, scale)
+	if err != nil || u.hi>>32 != 0 {
+		return buf, fmt.Errorf("%v too large for System.Decimal: %w", d, ErrRange)
+	}
+	if -int(dp.exp) > dotNetMaxScale {
+		err = ErrInexact
+	}
+
+	flags := uint32(scale) << dotNetScaleShift
+	if neg {
+		flags |= dotNetSignBit
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(u.lo))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(u.lo>>32))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(u.hi))
+	return binary.LittleEndian.AppendUint32(buf, flags), err
+}
+
+// DecodeDotNetDecimal decodes a 16-byte .NET System.Decimal.
+// It uses [DefaultScanContext].
+func DecodeDotNetDecimal(b []byte) (Decimal, error) {
+	return DefaultScanContext.DecodeDotNetDecimal(b)
+}
+
+// DecodeDotNetDecimal decodes a 16-byte .NET System.Decimal, as described
+// in [Context.AppendDotNetDecimal].
+//
+// System.Decimal holds up to 29 digits. Values with more than 16 significant
+// digits are rounded according to ctx and returned along with [ErrInexact].
+// DecodeDotNetDecimal reports an error if b isn't 16 bytes, or if the flags
+// word has a scale above 28 or any unused bits set.
+func (ctx Context) DecodeDotNetDecimal(b []byte) (Decimal, error) {
+	if len(b) != 16 {
+		return QNaN, fmt.Errorf("System.Decimal must be 16 bytes, got %d", len(b))
+	}
+	flags := binary.LittleEndian.Uint32(b[12:])
+	scale := int(flags&dotNetScaleMask) >> dotNetScaleShift
+	if flags&^(dotNetSignBit|dotNetScaleMask) != 0 || scale > dotNetMaxScale {
+		return QNaN, fmt.Errorf("invalid System.Decimal flags 0x%08x", flags)
+	}
+	var sign int8
+	if flags&dotNetSignBit != 0 {
+		sign = 1
+	}
+	c := uint128T{
+		lo: binary.LittleEndian.Uint64(b),
+		hi: uint64(binary.LittleEndian.Uint32(b[8:])),
+	}
+	return ctx.newFromCoefficient(sign, -scale, c, false)
+}
@@ -0,0 +1,78 @@
+package d64
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestDecimalAppendDotNetDecimal(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, s string) {
+		t.Helper()
+		d := MustParse(s)
+		buf, err := d.AppendDotNetDecimal(nil)
+		isnil(t, err)
+		equal(t, expected, fmt.Sprintf("%x", buf))
+
+		e, err := DecodeDotNetDecimal(buf)
+		isnil(t, err)
+		equal(t, d.bits, e.bits)
+	}
+
+	// lo, mid, hi and flags, each little-endian.
+	test("00000000"+"00000000"+"00000000"+"00000000", "0")
+	test("00000000"+"00000000"+"00000000"+"00000080", "-0")
+	test("01000000"+"00000000"+"00000000"+"00000000", "1")
+	test("7b000000"+"00000000"+"00000000"+"00000200", "1.23")
+	test("7b000000"+"00000000"+"00000000"+"00000280", "-1.23")
+	test("4061fa2a"+"298e7435"+"00000000"+"00000000", "3.8518598887744717e18")
+	test("0060eadd"+"596470c2"+"95d6757c"+"00000000", "3.851859888774471e28")
+	test("01000000"+"00000000"+"00000000"+"00001c00", "1e-28")
+
+	buf, err := MustParse("1.5e-28").AppendDotNetDecimal(nil)
+	check(t, errors.Is(err, ErrInexact))
+	equal(t, "02000000"+"00000000"+"00000000"+"00001c00", fmt.Sprintf("%x", buf))
+
+	_, err = MustParse("1e29").AppendDotNetDecimal(nil)
+	check(t, errors.Is(err, ErrRange))
+	_, err = MustParse("1e300").AppendDotNetDecimal(nil)
+	check(t, errors.Is(err, ErrRange))
+	buf, err = QNaN.AppendDotNetDecimal([]byte{})
+	notnil(t, err)
+	equal(t, 0, len(buf))
+}
+
+func TestDecodeDotNetDecimal(t *testing.T) {
+	t.Parallel()
+
+	dotnet := func(lo, mid, hi, flags uint32) []byte {
+		return []byte{
+			byte(lo), byte(lo >> 8), byte(lo >> 16), byte(lo >> 24),
+			byte(mid), byte(mid >> 8), byte(mid >> 16), byte(mid >> 24),
+			byte(hi), byte(hi >> 8), byte(hi >> 16), byte(hi >> 24),
+			byte(flags), byte(flags >> 8), byte(flags >> 16), byte(flags >> 24),
+		}
+	}
+
+	// decimal.MaxValue is 79228162514264337593543950335.
+	d, err := DecodeDotNetDecimal(dotnet(0xffffffff, 0xffffffff, 0xffffffff, 0))
+	check(t, errors.Is(err, ErrInexact))
+	equalD64(t, MustParse("7.922816251426434e28"), d)
+
+	d, err = Context{Rounding: Down}.DecodeDotNetDecimal(dotnet(0xffffffff, 0xffffffff, 0xffffffff, 0x80000000))
+	check(t, errors.Is(err, ErrInexact))
+	equalD64(t, MustParse("-7.922816251426433e28"), d)
+
+	d, err = DecodeDotNetDecimal(dotnet(1, 0, 0, 28<<16))
+	isnil(t, err)
+	equalD64(t, MustParse("1e-28"), d)
+
+	_, err = DecodeDotNetDecimal(dotnet(1, 0, 0, 29<<16))
+	notnil(t, err)
+	_, err = DecodeDotNetDecimal(dotnet(1, 0, 0, 1))
+	notnil(t, err)
+	_, err = DecodeDotNetDecimal(dotnet(1, 0, 0, 0)[:15])
+	notnil(t, err)
+}
@@ -5,3 +5,13 @@ type Error string
 func (e Error) Error() string {
 	return string(e)
 }
+
+// ErrInexact is reported when a value can't be represented as a [Decimal]
+// without rounding.
+var ErrInexact error = Error("value not exactly representable")
+
+// ErrRange is reported when a value lies outside the range of [Decimal].
+var ErrRange error = Error("value out of range")
+
+// ErrSyntax is reported when text doesn't represent a [Decimal].
+var ErrSyntax error = Error("invalid syntax")
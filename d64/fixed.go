@@ -0,0 +1,185 @@
+package d64
+
+import "fmt"
+
+// FieldSign selects how a [FixedField] carries the sign of a number.
+type FieldSign int8
+
+const (
+	// FieldUnsigned fields hold digits only and can't hold negative numbers.
+	FieldUnsigned FieldSign = iota
+
+	// FieldLeadingSign fields start with '+' or '-'.
+	FieldLeadingSign
+
+	// FieldCreditDebit fields start with 'C' for credit (positive) or 'D'
+	// for debit (negative), as in ISO 8583 amount fields.
+	FieldCreditDebit
+)
+
+func (s FieldSign) String() string {
+	switch s {
+	case FieldUnsigned:
+		return "FieldUnsigned"
+	case FieldLeadingSign:
+		return "FieldLeadingSign"
+	case FieldCreditDebit:
+		return "FieldCreditDebit"
+	default:
+		return fmt.Sprintf("Unknown field sign %d", s)
+	}
+}
+
+// FixedField describes a fixed-width numeric field with an implied decimal
+// point, as found in ISO 8583 messages, direct entry files and many other
+// flat file formats. For example, 12.34 in a field with Width 8, Scale 2 and
+// Sign FieldCreditDebit is "C0001234".
+//
+// Neither [FixedField.Append] nor [FixedField.Parse] allocates unless it
+// reports an error.
+type FixedField struct {
+	Width int       // Total width in bytes, including any sign.
+	Scale int       // Number of implied digits after the decimal point.
+	Sign  FieldSign // Sign convention.
+}
+
+// digits returns the number of digits in the field.
+func (f FixedField) digits() int {
+	if f.Sign == FieldUnsigned {
+		return f.Width
+	}
+	return f.Width - 1
+}
+
+func (f FixedField) check() error {
+	if f.Sign < FieldUnsigned || f.Sign > FieldCreditDebit {
+		return fmt.Errorf("invalid fixed field sign %d", f.Sign)
+	}
+	if n := f.digits(); n < 1 || f.Scale < 0 || f.Scale > n {
+		return fmt.Errorf("invalid fixed field %+v", f)
+	}
+	return nil
+}
+
+// Append appends d to buf as exactly f.Width bytes of zero-padded digits,
+// preceded by a sign if f has one. Zero is always positive.
+//
+// Append reports an error wrapping [ErrInexact] if d has more decimal places
+// than f.Scale, and [ErrRange] if d has too many integer digits for the
+// field. It also reports an error for NaN, ±∞, and negative numbers if f is
+// unsigned.
+func (f FixedField) Append(buf []byte, d Decimal) ([]byte, error) {
+	if err := f.check(); err != nil {
+		return buf, err
+	}
+	if !d.isFinite() {
+		return buf, fmt.Errorf("%v not valid in a fixed field", d)
+	}
+	var dp decParts
+	dp.unpack(d)
+	dp.trimZeros()
+	sig := dp.significand.lo
+	neg := dp.sign == 1 && sig != 0
+
+	n := f.digits()
+	nd, shift := 0, 0
+	if sig != 0 {
+		nd, shift = numDecimalDigitsU64(sig), int(dp.exp)+f.Scale
+		switch {
+		case shift < 0:
+			return buf, fmt.Errorf("%v has more than %d decimal places: %w", d, f.Scale, ErrInexact)
+		case nd+shift > n:
+			return buf, fmt.Errorf("%v too large for %d digits: %w", d, n, ErrRange)
+		}
+	}
+
+	switch f.Sign {
+	case FieldUnsigned:
+		if neg {
+			return buf, fmt.Errorf("%v not valid in an unsigned field", d)
+		}
+	case FieldLeadingSign:
+		if neg {
+			buf = append(buf, '-')
+		} else {
+			buf = append(buf, '+')
+		}
+	case FieldCreditDebit:
+		if neg {
+			buf = append(buf, 'D')
+		} else {
+			buf = append(buf, 'C')
+		}
+	}
+	buf = appendZeros(buf, n-nd-shift)
+	if nd > 0 {
+		buf = formatBits10(buf, sig, nd)
+	}
+	return appendZeros(buf, shift), nil
+}
+
+// Parse parses exactly f.Width bytes of field data as a [Decimal].
+//
+// Parse reports an error if b has the wrong length, a missing or invalid
+// sign, or anything other than digits after the sign. It reports an error
+// wrapping [ErrInexact] if the value has more than 16 significant digits or
+// is too close to zero, and [ErrRange] if it is too large.
+func (f FixedField) Parse(b []byte) (Decimal, error) {
+	if err := f.check(); err != nil {
+		return QNaN, err
+	}
+	if len(b) != f.Width {
+		return QNaN, fmt.Errorf("fixed field has %d bytes, want %d", len(b), f.Width)
+	}
+
+	var sign int8
+	switch f.Sign {
+	case FieldLeadingSign:
+		switch b[0] {
+		case '+':
+		case '-':
+			sign = 1
+		default:
+			return QNaN, fmt.Errorf("invalid sign %q in fixed field", b[0])
+		}
+		b = b[1:]
+	case FieldCreditDebit:
+		switch b[0] {
+		case 'C':
+		case 'D':
+			sign = 1
+		default:
+			return QNaN, fmt.Errorf("invalid sign %q in fixed field", b[0])
+		}
+		b = b[1:]
+	}
+
+	// 38 digits always fit in 128 bits. Digits beyond that are either
+	// trailing zeros or make the result inexact.
+	var c uint128T
+	exp := -f.Scale
+	sticky := false
+	digits := 0
+	for _, r := range b {
+		if !isDigit(rune(r)) {
+			return QNaN, fmt.Errorf("invalid digit %q in fixed field", r)
+		}
+		x := uint64(r - '0')
+		switch {
+		case digits == 0 && x == 0:
+		case digits < 38:
+			c.mul64(&c, 10)
+			c.add(&c, &uint128T{lo: x})
+			digits++
+		default:
+			exp++
+			sticky = sticky || x != 0
+		}
+	}
+
+	d, err := DefaultContext.newFromCoefficient(sign, exp, c, sticky)
+	if err != nil {
+		return QNaN, fmt.Errorf("fixed field: %w", err)
+	}
+	return d, nil
+}
@@ -0,0 +1,133 @@
+package d64
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestFixedFieldAppend(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, f FixedField, s string) {
+		t.Helper()
+		d := MustParse(s)
+		buf, err := f.Append(nil, d)
+		isnil(t, err)
+		equal(t, expected, string(buf))
+
+		e, err := f.Parse(buf)
+		isnil(t, err)
+		check(t, d.Equal(e))
+	}
+
+	iso := FixedField{Width: 12, Scale: 2}
+	cd := FixedField{Width: 9, Scale: 2, Sign: FieldCreditDebit}
+	signed := FixedField{Width: 6, Scale: 3, Sign: FieldLeadingSign}
+
+	test("000000001234", iso, "12.34")
+	test("000000001200", iso, "12")
+	test("999999999999", iso, "9999999999.99")
+	test("000000000000", iso, "0")
+	test("C00001234", cd, "12.34")
+	test("D00001234", cd, "-12.34")
+	test("C00000000", cd, "-0")
+	test("-00001", signed, "-0.001")
+	test("+12345", signed, "12.345")
+	test("1000000000000000000000", FixedField{Width: 22}, "1e21")
+	test("0000000000000000000000000000000001", FixedField{Width: 34, Scale: 34}, "1e-34")
+
+	invalid := func(target error, f FixedField, d Decimal) {
+		t.Helper()
+		buf, err := f.Append([]byte("x"), d)
+		notnil(t, err)
+		equal(t, "x", string(buf))
+		if target != nil {
+			check(t, errors.Is(err, target))
+		}
+	}
+
+	invalid(ErrInexact, iso, MustParse("1.234"))
+	invalid(ErrRange, iso, MustParse("1e10"))
+	invalid(ErrRange, cd, MustParse("1e300"))
+	invalid(nil, iso, MustParse("-1"))
+	invalid(nil, cd, QNaN)
+	invalid(nil, cd, Inf)
+	invalid(nil, FixedField{Width: 1, Sign: FieldLeadingSign}, One)
+	invalid(nil, FixedField{Width: 2, Scale: 3}, One)
+	invalid(nil, FixedField{Width: 2, Sign: 3}, One)
+}
+
+func TestFixedFieldParse(t *testing.T) {
+	t.Parallel()
+
+	valid := func(expected string, f FixedField, s string) {
+		t.Helper()
+		d, err := f.Parse([]byte(s))
+		isnil(t, err)
+		equalD64(t, MustParse(expected), d)
+	}
+	invalid := func(target error, f FixedField, s string) {
+		t.Helper()
+		_, err := f.Parse([]byte(s))
+		notnil(t, err)
+		if target != nil {
+			check(t, errors.Is(err, target))
+		}
+	}
+
+	iso := FixedField{Width: 12, Scale: 2}
+	cd := FixedField{Width: 9, Scale: 2, Sign: FieldCreditDebit}
+	wide := FixedField{Width: 40}
+
+	valid("12.34", iso, "000000001234")
+	valid("-0.01", cd, "D00000001")
+	valid("1e39", wide, "1000000000000000000000000000000000000000")
+	valid("1234567890123456", wide, "0000000000000000000000001234567890123456")
+
+	invalid(nil, iso, "00000001234")
+	invalid(nil, iso, "0000000012345")
+	invalid(nil, iso, "00000000123 ")
+	invalid(nil, iso, "+00000001234")
+	invalid(nil, cd, "X00001234")
+	invalid(nil, cd, "c00001234")
+	invalid(nil, FixedField{Width: 9, Sign: FieldLeadingSign}, " 00001234")
+	invalid(ErrInexact, wide, "0000000000000000000000012345678901234567")
+	invalid(ErrInexact, wide, "1000000000000000000000000000000000000001")
+	invalid(ErrInexact, FixedField{Width: 400, Scale: 400}, strings.Repeat("0", 399)+"1")
+	invalid(ErrRange, FixedField{Width: 400}, "1"+strings.Repeat("0", 399))
+}
+
+func TestFixedFieldNoAllocs(t *testing.T) {
+	if unsafe.Sizeof(Zero) != unsafe.Sizeof(uint64(0)) {
+		t.Skip("decimal_debug builds allocate debug strings")
+	}
+	f := FixedField{Width: 12, Scale: 2, Sign: FieldCreditDebit}
+	d := MustParse("-1234.56")
+	var buf [16]byte
+	equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		_, _ = f.Append(buf[:0], d)
+	}))
+	text := []byte("D00000123456")
+	equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		_, _ = f.Parse(text)
+	}))
+}
+
+func BenchmarkFixedFieldAppend(b *testing.B) {
+	f := FixedField{Width: 12, Scale: 2, Sign: FieldCreditDebit}
+	d := MustParse("-1234.56")
+	var buf [16]byte
+	for i := 0; i <= b.N; i++ {
+		_, _ = f.Append(buf[:0], d)
+	}
+}
+
+func BenchmarkFixedFieldParse(b *testing.B) {
+	f := FixedField{Width: 12, Scale: 2, Sign: FieldCreditDebit}
+	text := []byte("D00000123456")
+	for i := 0; i <= b.N; i++ {
+		_, _ = f.Parse(text)
+	}
+}
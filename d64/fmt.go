@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 var _ fmt.Formatter = Zero
@@ -91,6 +93,9 @@ func appendFracE(buf []byte, n uint64) []byte {
 }
 
 // Append appends the text representation of d to buf.
+// The format is one of 'e', 'E', 'f', 'F', 'g' or 'G', as for
+// [strconv.AppendFloat], or 'n' for engineering notation as for
+// [Decimal.EngString], which ignores prec.
 func (d Decimal) Append(buf []byte, format byte, prec int) []byte {
 	return DefaultFormatContext.append(d, buf, -1, prec, noFlags, rune(format))
 }
@@ -112,7 +117,20 @@ func (ctx Context) append(
 		buf = make([]byte, 0, 32)
 	}
 	a := appender{wid, prec, flagger}
+	start := len(buf)
+	buf = ctx.appendNumber(&a, d, buf, verb)
+	if verb != 'e' && verb != 'E' && verb != 'f' && verb != 'F' && verb != 'g' && verb != 'G' {
+		return buf
+	}
+	return a.pad(buf, start, d.isFinite())
+}
 
+// appendNumber appends the text representation of d to buf, with a sign
+// only if d is negative and without padding.
+func (ctx Context) appendNumber(a *appender, d Decimal, buf []byte, verb rune) []byte {
+	if verb == 'n' {
+		return d.appendSci(buf, true)
+	}
 	flav, sign, exp, significand := d.parts()
 	if sign == 1 {
 		buf = append(buf, '-')
@@ -132,6 +150,9 @@ formatBlock:
 	switch verb {
 	case 'e', 'E':
 		exp, significand = unsubnormal(exp, significand)
+		if a.prec >= 0 {
+			return ctx.appendPrecE(buf, verb, exp, significand, a.prec)
+		}
 
 		whole := significand / decimalBase
 		buf = append(buf, byte('0'+whole))
@@ -200,9 +221,21 @@ formatBlock:
 		buf = appendZeros(buf, min(a.prec, prefix))
 		return appendFracF(buf, significand, fracDigits, a.prec-prefix)
 	case 'g', 'G':
-		if exp < -decimalDigits-3 ||
-			a.prec >= 0 && int(exp) > a.prec ||
-			a.prec < 0 && exp > -decimalDigits+6 {
+		if a.prec >= 0 {
+			// As for floats, precision is the number of significant digits.
+			exp, significand = ctx.roundDigits(exp, significand, max(a.prec, 1))
+			if useExp(exp, significand, max(a.prec, 1)) {
+				// As for floats, trailing zeros are dropped and the exponent
+				// has at least two digits.
+				digits := decimalDigits
+				for s := significand; s%10 == 0; s /= 10 {
+					digits--
+				}
+				return ctx.appendPrecE(buf, verb-('g'-'e'), exp, significand, digits-1)
+			}
+			verb -= 'g' - 'f'
+			a.prec = -1
+		} else if exp < -decimalDigits-3 || exp > -decimalDigits+6 {
 			verb -= 'g' - 'e'
 		} else {
 			verb -= 'g' - 'f'
@@ -213,6 +246,123 @@ formatBlock:
 	}
 }
 
+// roundDigits rounds significand × 10^exp to n significant digits with ctx.
+// The result may not be encodable as a [Decimal].
+func (ctx Context) roundDigits(exp int16, significand uint64, n int) (int16, uint64) {
+	exp, significand = unsubnormal(exp, significand)
+	if significand == 0 || n >= decimalDigits {
+		return exp, significand
+	}
+	drop := decimalDigits - n
+	significand = ctx.Rounding.round(significand/tenToThe[drop], roundStatus(significand, int16(drop)))
+	significand *= tenToThe[drop]
+	if significand >= 10*decimalBase {
+		significand /= 10
+		exp++
+	}
+	return exp, significand
+}
+
+// appendPrecE appends significand × 10^exp, which must be unsubnormal, in
+// exponent form with prec digits after the point, as strconv does for floats.
+func (ctx Context) appendPrecE(buf []byte, verb rune, exp int16, significand uint64, prec int) []byte {
+	exp, significand = ctx.roundDigits(exp, significand, prec+1)
+	whole := significand / decimalBase
+	buf = append(buf, byte('0'+whole))
+	if prec > 0 {
+		buf = append(buf, '.')
+		buf = appendFracF(buf, significand-decimalBase*whole, 15, prec)
+	}
+
+	x := int(exp) + 15
+	if significand == 0 {
+		x = 0
+	}
+	buf = append(buf, byte(verb))
+	if x < 0 {
+		buf = append(buf, '-')
+		x = -x
+	} else {
+		buf = append(buf, '+')
+	}
+	if x < 10 {
+		buf = append(buf, '0')
+	}
+	return strconv.AppendInt(buf, int64(x), 10)
+}
+
+// useExp reports whether %g with precision prec formats significand × 10^exp,
+// which must be unsubnormal, in exponent form. It uses the rule in strconv:
+// exponent form is used if the exponent is less than -4 or at least prec, or
+// the number of digits for a number with no fractional part.
+func useExp(exp int16, significand uint64, prec int) bool {
+	if significand == 0 {
+		return false
+	}
+	digits := decimalDigits
+	for significand%10 == 0 {
+		significand /= 10
+		digits--
+	}
+	x := int(exp) + decimalDigits - 1 // Exponent with one digit before the point
+	if prec > digits && digits >= x+1 {
+		prec = digits
+	}
+	return x < -4 || x >= prec
+}
+
+var spaces = [16]byte{
+	' ', ' ', ' ', ' ', ' ', ' ', ' ', ' ',
+	' ', ' ', ' ', ' ', ' ', ' ', ' ', ' ',
+}
+
+func appendSpaces(buf []byte, n int) []byte {
+	for ; n > len(spaces); n -= len(spaces) {
+		buf = append(buf, spaces[:]...)
+	}
+	return append(buf, spaces[:max(n, 0)]...)
+}
+
+// pad applies the sign flags and width of a to the number in buf[start:],
+// as fmt does for floats. Numbers that aren't finite are never padded with
+// zeros.
+func (a *appender) pad(buf []byte, start int, finite bool) []byte {
+	plus, space := a.flagger.Flag('+'), a.flagger.Flag(' ')
+	if (plus || space) && (len(buf) == start || buf[start] != '-') {
+		sign := byte(' ')
+		if plus {
+			sign = '+'
+		}
+		buf = append(buf, 0)
+		copy(buf[start+1:], buf[start:])
+		buf[start] = sign
+	}
+
+	n := a.wid - utf8.RuneCount(buf[start:])
+	if n <= 0 {
+		return buf
+	}
+	if a.flagger.Flag('-') {
+		return appendSpaces(buf, n)
+	}
+	zero := a.flagger.Flag('0') && finite
+	at := start
+	if c := buf[start]; zero && (c == '-' || c == '+' || c == ' ') {
+		at++
+	}
+	end := len(buf)
+	buf = appendSpaces(buf, n)
+	copy(buf[at+n:], buf[at:end])
+	fill := byte(' ')
+	if zero {
+		fill = '0'
+	}
+	for i := at; i < at+n; i++ {
+		buf[i] = fill
+	}
+	return buf
+}
+
 // Format implements fmt.Formatter.
 func (d Decimal) Format(s fmt.State, verb rune) {
 	DefaultFormatContext.format(d, s, verb)
@@ -229,7 +379,24 @@ func (ctx Context) format(d Decimal, s fmt.State, verb rune) {
 		}
 	case 'g', 'G':
 	case 'v':
+		if s.Flag('#') {
+			fmt.Fprintf(s, "d64.MustParse(%q)", ctx.goString(d))
+			return
+		}
 		verb = 'g'
+		if s.Flag('+') {
+			// As for floats, %+v doesn't force a sign.
+			s = plusVState{s}
+		}
+	case 's', 'q':
+		fmt.Fprintf(s, fmt.FormatString(s, verb), ctx.str(d))
+		return
+	case 'd':
+		if !d.IsInt() {
+			fmt.Fprintf(s, "%%!d(d64.Decimal=%s)", d.String())
+			return
+		}
+		verb, prec = 'f', 0
 	default:
 		fmt.Fprintf(s, "%%!%c(d64.Decimal=%s)", verb, d.String())
 		return
@@ -240,6 +407,25 @@ func (ctx Context) format(d Decimal, s fmt.State, verb rune) {
 	s.Write(ctx.append(d, nil, wid, prec, s, verb)) //nolint:errcheck
 }
 
+// goString returns the text of d for %#v, which parses as d. Unlike
+// [Decimal.String], it distinguishes signalling NaNs.
+func (ctx Context) goString(d Decimal) string {
+	text := ctx.str(d)
+	if d.IsSNaN() {
+		return strings.Replace(text, "NaN", "sNaN", 1)
+	}
+	return text
+}
+
+// plusVState hides the '+' flag, which means %+v rather than %+g.
+type plusVState struct {
+	fmt.State
+}
+
+func (s plusVState) Flag(c int) bool {
+	return c != '+' && s.State.Flag(c)
+}
+
 func optInt(i int, has bool) int {
 	if has {
 		return i
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"unsafe"
 )
 
 func TestPrecScal(t *testing.T) {
@@ -138,7 +139,7 @@ func TestDecimalFormatPrec(t *testing.T) {
 	equal(t, "3.141592653589793", Context{Rounding: HalfUp}.With(pi).String())
 	equal(t, "3.141592653589793", fmt.Sprintf("%v", pi))
 	equal(t, "3.141593", fmt.Sprintf("%f", pi))
-	equal(t, "%!q(d64.Decimal=3.141592653589793)", fmt.Sprintf("%q", pi))
+	equal(t, `"3.141592653589793"`, fmt.Sprintf("%q", pi))
 
 	test("3", 0, pi)
 	test("3.1", 1, pi)
@@ -308,6 +309,155 @@ func BenchmarkIODecimalFormat(b *testing.B) {
 	}
 }
 
+func TestDecimalFormatWidthFlags(t *testing.T) {
+	t.Parallel()
+
+	// Formats whose output matches float64 for these values.
+	formats := []string{
+		"%12.2f", "%-12.2f", "%+.2f", "% .2f", "%012.2f", "%+012.2f", "% 012.2f",
+		"%-012.2f", "%+-12.2f", "%3.2f", "%12f", "%12v", "%-12v", "%+v", "%012v",
+		"%12g", "%+g", "% g", "%012g",
+		"%e", "%12E", "%12.3e", "%-12.2E", "%+.1e", "% .0e", "%012.4e", "%.3E", "%.20e",
+		"%12.2g", "%-12.2G", "%+012.2g", "% .1g", "%012.3G",
+	}
+	for _, f := range []float64{0, 1.5, -1.5, 1234.25, -1234.25, 0.125} {
+		d := NewFromFloat64(f)
+		for _, format := range formats {
+			equal(t, fmt.Sprintf(format, f), fmt.Sprintf(format, d)).Or(func() {
+				t.Errorf("format %q of %v", format, f)
+			})
+		}
+	}
+
+	test := func(expected, format string, d Decimal) {
+		t.Helper()
+		equal(t, expected, fmt.Sprintf(format, d))
+	}
+	test("1.500000e+20", "%10e", MustParse("1.5e20"))
+	test("     1.5e+20", "%12.1e", MustParse("1.5e20"))
+	test("+001.5e+20", "%+010.1e", MustParse("1.5e20"))
+	test("1.5e+20   |", "%-10.1e|", MustParse("1.5e20"))
+	test("   1.235e+03", "%12.3e", MustParse("1234.5678"))
+	test("   1.23e+03", "%11.3g", MustParse("1234.5678"))
+	test("+0001.2E-05", "%+011.2G", MustParse("0.000012345"))
+	test("1.2e+03    |", "%-11.2g|", MustParse("1234.5678"))
+	test("1.2E-300", "%.1E", MustParse("1.25e-300"))
+	test("1.0e+385", "%.1e", MustParse("9.99e384"))
+	test("1.000000000000000000e-398", "%.18e", MustParse("1e-398"))
+	test("        NaN", "%11f", QNaN)
+	test("       +NaN", "%+011f", QNaN)
+	test("NaN        |", "%-011f|", QNaN)
+	test("        inf", "%011.2f", Inf)
+	test("       -inf", "%011.2f", NegInf)
+	test("       +inf", "%+11g", Inf)
+	test("       -0.00", "%12.2f", NegZero)
+	test("-00000000.00", "%012.2f", NegZero)
+	test("000000001234.50", "%015.2f", MustParse("1234.5"))
+
+	equal(t, "  1.50", Context{}.With(MustParse("1.5")).Text('f', 6, 2))
+}
+
+func TestDecimalFormatGPrec(t *testing.T) {
+	t.Parallel()
+
+	// Values that float64 represents exactly, so rounding agrees.
+	for _, f := range []float64{
+		0, 0.5, -2.75, 1.25, 100, 999.5, 123456, 1048576, 0.0001220703125,
+		3.0517578125e-05, 1e15, 1e21,
+	} {
+		d := NewFromFloat64(f)
+		for prec := 0; prec <= 17; prec++ {
+			for _, format := range []string{"%.*g", "%.*G"} {
+				expected := fmt.Sprintf(format, prec, f)
+				equal(t, expected, fmt.Sprintf(format, prec, d)).Or(func() {
+					t.Errorf("format %q with precision %d of %v", format, prec, f)
+				})
+			}
+		}
+	}
+
+	test := func(expected string, d Decimal, prec int) {
+		t.Helper()
+		equal(t, expected, d.Text('g', prec))
+	}
+	test("1e+385", MustParse("9.999999999999999e384"), 15)
+	test("1.234567890123457e-380", MustParse("1.234567890123457e-380"), 16)
+	test("1.2346e-390", MustParse("1.23456789e-390"), 5)
+	test("0.0001", MustParse("0.0000999"), 2)
+	test("9.99e-05", MustParse("0.0000999"), 3)
+	test("-1e+02", MustParse("-99.5"), 2)
+	test("NaN", QNaN, 3)
+	test("-inf", NegInf, 3)
+	equal(t, "  1.23e+08", fmt.Sprintf("%10.3g", MustParse("123456789")))
+	equal(t, "1.23e+08", fmt.Sprintf("%08.3g", MustParse("123456789")))
+	equal(t, "001.2E+08", fmt.Sprintf("%09.2G", MustParse("123456789")))
+	equal(t, "1.23e-05", fmt.Sprintf("%.3g", MustParse("0.000012345")))
+	equal(t, "-1.2e-05  |", fmt.Sprintf("%-10.2g|", MustParse("-0.000012345")))
+	equal(t, "3", fmt.Sprintf("%.1g", Context{Rounding: HalfUp}.With(MustParse("2.5"))))
+	equal(t, "2", fmt.Sprintf("%.1g", MustParse("2.5")))
+}
+
+func TestDecimalFormatVerbs(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected, format string, d Decimal) {
+		t.Helper()
+		equal(t, expected, fmt.Sprintf(format, d))
+	}
+	pi := MustParse("3.141592653589793")
+	test("3.141592653589793", "%s", pi)
+	test("      1e+300", "%12s", MustParse("1e300"))
+	test("-inf  |", "%-6s|", NegInf)
+	test(`"3.141592653589793"`, "%q", pi)
+	test(`"NaN"`, "%q", QNaN)
+
+	test("42", "%d", MustParse("42"))
+	test("-42", "%d", MustParse("-4.20e1"))
+	test("   +42", "%+6d", MustParse("42"))
+	test("-00042", "%06d", MustParse("-42"))
+	test("100000000000000000000", "%d", MustParse("1e20"))
+	test("0", "%d", NegZero.Neg())
+	test("%!d(d64.Decimal=1.5)", "%d", MustParse("1.5"))
+	test("%!d(d64.Decimal=inf)", "%d", Inf)
+	test("%!d(d64.Decimal=NaN)", "%d", QNaN)
+
+	test(`d64.MustParse("3.141592653589793")`, "%#v", pi)
+	test(`d64.MustParse("-inf")`, "%#v", NegInf)
+	test(`d64.MustParse("sNaN")`, "%#v", SNaN)
+	equal(t, `[]d64.Decimal{d64.MustParse("1"), d64.MustParse("-0")}`, fmt.Sprintf("%#v", []Decimal{One, NegZero}))
+
+	for _, d := range []Decimal{pi, Zero, NegZero, Inf, NegInf, QNaN, SNaN, Max, Min, NewFromInt64(-7)} {
+		text := fmt.Sprintf("%#v", d)
+		q := strings.TrimSuffix(strings.TrimPrefix(text, "d64.MustParse("), ")")
+		s, err := strconv.Unquote(q)
+		isnil(t, err)
+		equal(t, d.bits, MustParse(s).bits).Or(func() {
+			t.Errorf("%s doesn't round trip", text)
+		})
+	}
+}
+
+func TestDecimalAppendAllocs(t *testing.T) {
+	if unsafe.Sizeof(Zero) != unsafe.Sizeof(uint64(0)) {
+		t.Skip("decimal_debug builds allocate debug strings")
+	}
+	d := MustParse("-1234.5678")
+	var buf [32]byte
+	equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		_ = d.Append(buf[:0], 'f', 2)
+	}))
+	equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		_ = DefaultFormatContext.append(d, buf[:0], 20, 2, flagsInt('+'), 'f')
+	}))
+}
+
+// flagsInt is a flagger that reports the flags it holds.
+type flagsInt string
+
+func (f flagsInt) Flag(c int) bool {
+	return strings.ContainsRune(string(f), rune(c))
+}
+
 func TestDecimalAppend(t *testing.T) {
 	t.Parallel()
 
@@ -317,29 +467,29 @@ func TestDecimalAppend(t *testing.T) {
 
 	for i := int64(-1000); i <= 1000; i++ {
 		d := NewFromInt64(i)
-		f := d.Append([]byte{}, 'g', 0)
+		f := d.Append([]byte{}, 'g', -1)
 		equal(t, strconv.FormatInt(i, 10), string(f))
 	}
 
-	assertAppend("NaN", QNaN, 'g', 0)
-	assertAppend("inf", Inf, 'g', 0)
-	assertAppend("-inf", NegInf, 'g', 0)
-	assertAppend("-0", NegZero, 'g', 0)
+	assertAppend("NaN", QNaN, 'g', -1)
+	assertAppend("inf", Inf, 'g', -1)
+	assertAppend("-inf", NegInf, 'g', -1)
+	assertAppend("-0", NegZero, 'g', -1)
 	assertAppend("NaN", QNaN, 'f', 0)
 	assertAppend("NaN", SNaN, 'f', 0)
 	assertAppend("inf", Inf, 'f', 0)
 	assertAppend("-inf", NegInf, 'f', 0)
 	assertAppend("%w", Zero, 'w', 0)
 
-	assertAppend("1.23456789e+8", MustParse("123456789"), 'e', 0)
-	assertAppend("1.23456789e+18", MustParse("123456789e10"), 'e', 0)
-	assertAppend("1.23456789e-18", MustParse("123456789e-26"), 'e', 0)
+	assertAppend("1.23456789e+8", MustParse("123456789"), 'e', -1)
+	assertAppend("1.23456789e+18", MustParse("123456789e10"), 'e', -1)
+	assertAppend("1.23456789e-18", MustParse("123456789e-26"), 'e', -1)
 	assertAppend("1234567890000000000", MustParse("123456789e10"), 'f', 0)
 
-	assertAppend("123456789", MustParse("123456789"), 'g', 0)
-	assertAppend("1.23456789e+18", MustParse("123456789e10"), 'g', 0)
-	assertAppend("1.23456789e-18", MustParse("123456789e-26"), 'g', 0)
-	assertAppend("1.23456789e+18", MustParse("123456789e10"), 'g', 0)
+	assertAppend("123456789", MustParse("123456789"), 'g', 9)
+	assertAppend("1.23456789e+18", MustParse("123456789e10"), 'g', -1)
+	assertAppend("1.23456789e-18", MustParse("123456789e-26"), 'g', -1)
+	assertAppend("1.2346e+18", MustParse("123456789e10"), 'g', 5)
 
 }
 
@@ -0,0 +1,32 @@
+// Package expect provides the assertions shared by the tests of the d64
+// subpackages.
+package expect
+
+import (
+	"errors"
+	"testing"
+)
+
+// Equal reports an error unless a == b.
+func Equal[T comparable](t *testing.T, a, b T) {
+	t.Helper()
+	if a != b {
+		t.Errorf("expected %+v, got %+v", a, b)
+	}
+}
+
+// Nil reports an error unless a is nil.
+func Nil(t *testing.T, a any) {
+	t.Helper()
+	if a != nil {
+		t.Errorf("expected nil, got %+v", a)
+	}
+}
+
+// ErrorIs reports an error unless errors.Is(err, target).
+func ErrorIs(t *testing.T, err, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Errorf("expected %v, got %v", target, err)
+	}
+}
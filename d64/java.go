@@ -0,0 +1,47 @@
+package d64
+
+import "fmt"
+
+// AppendBigDecimal appends the unscaled value of d to buf as a big-endian
+// two's complement integer and returns it along with the scale, so that d
+// equals unscaled × 10^-scale. These are the two components of a Java
+// BigDecimal, as returned by unscaledValue().toByteArray() and scale(), and
+// as used by Kafka Connect and Debezium.
+//
+// The conversion is always exact. Trailing zeros are removed from the
+// unscaled value, so 1.50 has unscaled value 15 and scale 1. Integers below
+// 10¹⁶ have a scale of 0, so 1000 has unscaled value 1000, but larger
+// integers may have a negative scale, such as 1E+20 with unscaled value 1
+// and scale -20.
+// AppendBigDecimal reports an error for NaN and ±∞.
+func (d Decimal) AppendBigDecimal(buf []byte) (unscaled []byte, scale int32, err error) {
+	if !d.isFinite() {
+		return buf, 0, fmt.Errorf("%v not valid as BigDecimal", d)
+	}
+	var dp decParts
+	dp.unpack(d)
+	dp.trimZeros()
+	scale = -int32(dp.exp)
+	unscaled, err = DefaultContext.AppendUnscaled(buf, d, int(scale), 0)
+	return unscaled, scale, err
+}
+
+// NewFromBigDecimal returns unscaled × 10^-scale, where unscaled is a
+// big-endian two's complement integer, as for the components of a Java
+// BigDecimal. It uses [DefaultScanContext].
+func NewFromBigDecimal(unscaled []byte, scale int32) (Decimal, error) {
+	return DefaultScanContext.NewFromBigDecimal(unscaled, scale)
+}
+
+// NewFromBigDecimal returns unscaled × 10^-scale, where unscaled is a
+// big-endian two's complement integer, as for the components of a Java
+// BigDecimal.
+//
+// Values with more than 16 significant digits are rounded according to ctx
+// and returned along with [ErrInexact]. Values too large for a [Decimal]
+// become ±∞ and are returned along with [ErrRange].
+func (ctx Context) NewFromBigDecimal(unscaled []byte, scale int32) (Decimal, error) {
+	// Bound the scale, as -math.MinInt32 overflows an int on 32-bit
+	// platforms. Every nonzero value overflows or underflows well before it.
+	return ctx.DecodeUnscaled(unscaled, int(max(-parseMaxExp, min(scale, parseMaxExp))))
+}
@@ -0,0 +1,68 @@
+package d64
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestDecimalAppendBigDecimal(t *testing.T) {
+	t.Parallel()
+
+	test := func(expectedUnscaled string, expectedScale int32, s string) {
+		t.Helper()
+		d := MustParse(s)
+		unscaled, scale, err := d.AppendBigDecimal(nil)
+		isnil(t, err)
+		equal(t, expectedUnscaled, fmt.Sprintf("%x", unscaled))
+		equal(t, expectedScale, scale)
+
+		e, err := NewFromBigDecimal(unscaled, scale)
+		isnil(t, err)
+		check(t, d.Equal(e))
+	}
+
+	test("00", 0, "0")
+	test("0f", 1, "1.50")
+	test("f1", 1, "-1.5")
+	test("03e8", 0, "1000")
+	test("01", -20, "1e20")
+	test("01", 398, "1e-398")
+	test("2386f26fc0ffff", -369, "9.999999999999999e384")
+
+	_, _, err := Inf.AppendBigDecimal(nil)
+	notnil(t, err)
+}
+
+func TestNewFromBigDecimal(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewFromBigDecimal([]byte{0x04, 0xd2}, 2)
+	isnil(t, err)
+	equalD64(t, MustParse("12.34"), d)
+
+	// 12345678901234567 needs 17 digits.
+	d, err = NewFromBigDecimal([]byte{0x2b, 0xdc, 0x54, 0x5d, 0x6b, 0x4b, 0x87}, 0)
+	check(t, errors.Is(err, ErrInexact))
+	equalD64(t, MustParse("1.234567890123457e16"), d)
+
+	d, err = Context{Rounding: Down}.NewFromBigDecimal([]byte{0x2b, 0xdc, 0x54, 0x5d, 0x6b, 0x4b, 0x87}, 0)
+	check(t, errors.Is(err, ErrInexact))
+	equalD64(t, MustParse("1.234567890123456e16"), d)
+
+	_, err = NewFromBigDecimal([]byte{1}, -400)
+	check(t, errors.Is(err, ErrRange))
+
+	d, err = NewFromBigDecimal([]byte{0xff}, math.MinInt32)
+	check(t, errors.Is(err, ErrRange))
+	equal(t, NegInf, d)
+
+	d, err = NewFromBigDecimal([]byte{0x2b, 0xdc, 0x54, 0x5d, 0x6b, 0x4b, 0x87}, math.MinInt32)
+	check(t, errors.Is(err, ErrRange))
+	equal(t, Inf, d)
+
+	d, err = NewFromBigDecimal([]byte{1}, math.MaxInt32)
+	check(t, errors.Is(err, ErrInexact))
+	equal(t, Zero, d)
+}
@@ -1,16 +1,188 @@
 package d64
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
 
 var _ json.Marshaler = Zero
 var _ json.Unmarshaler = (*Decimal)(nil)
 
-// MarshalText implements the encoding.TextMarshaler interface.
+var jsonNull = []byte("null")
+
+// JSONStyle selects how finite numbers are encoded as JSON.
+type JSONStyle int8
+
+const (
+	// JSONAsNumber encodes finite numbers as JSON numbers, e.g., 1.23.
+	JSONAsNumber JSONStyle = iota
+
+	// JSONAsString encodes finite numbers as JSON strings, e.g., "1.23".
+	JSONAsString
+)
+
+func (s JSONStyle) String() string {
+	switch s {
+	case JSONAsNumber:
+		return "JSONAsNumber"
+	case JSONAsString:
+		return "JSONAsString"
+	default:
+		return fmt.Sprintf("Unknown JSON style %d", s)
+	}
+}
+
+// JSONNonFinite selects how NaN and ±∞, which JSON numbers can't represent,
+// are encoded as JSON.
+type JSONNonFinite int8
+
+const (
+	// NonFiniteString encodes NaN and ±∞ as the strings "NaN", "Infinity"
+	// and "-Infinity".
+	NonFiniteString JSONNonFinite = iota
+
+	// NonFiniteNull encodes NaN and ±∞ as null.
+	NonFiniteNull
+
+	// NonFiniteError reports a [*json.UnsupportedValueError] for NaN and ±∞.
+	NonFiniteError
+)
+
+func (n JSONNonFinite) String() string {
+	switch n {
+	case NonFiniteString:
+		return "NonFiniteString"
+	case NonFiniteNull:
+		return "NonFiniteNull"
+	case NonFiniteError:
+		return "NonFiniteError"
+	default:
+		return fmt.Sprintf("Unknown JSON non-finite policy %d", n)
+	}
+}
+
+// JSONOptions controls how a [Decimal] is encoded as JSON.
+// Decoding always accepts both numbers and strings, and ignores null.
+type JSONOptions struct {
+	Style     JSONStyle
+	NonFinite JSONNonFinite
+}
+
+// DefaultJSONOptions is used by [Decimal.MarshalJSON] and
+// [Decimal.UnmarshalJSON].
+// It encodes finite numbers as JSON numbers and NaN and ±∞ as strings.
+var DefaultJSONOptions = JSONOptions{}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It uses [DefaultJSONOptions].
 func (d Decimal) MarshalJSON() ([]byte, error) {
-	return d.MarshalText()
+	return DefaultJSONOptions.Marshal(d)
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It uses [DefaultJSONOptions].
 func (d *Decimal) UnmarshalJSON(data []byte) error {
-	return d.UnmarshalText(data)
+	return DefaultJSONOptions.Unmarshal(data, d)
+}
+
+// Marshal returns the JSON encoding of d.
+func (o JSONOptions) Marshal(d Decimal) ([]byte, error) {
+	return o.Append(nil, d)
+}
+
+// Append appends the JSON encoding of d to buf.
+func (o JSONOptions) Append(buf []byte, d Decimal) ([]byte, error) {
+	if !d.isFinite() {
+		switch o.NonFinite {
+		case NonFiniteNull:
+			return append(buf, jsonNull...), nil
+		case NonFiniteError:
+			return buf, &json.UnsupportedValueError{Str: d.String()}
+		}
+		switch {
+		case d.IsNaN():
+			return append(buf, `"NaN"`...), nil
+		case d.Signbit():
+			return append(buf, `"-Infinity"`...), nil
+		default:
+			return append(buf, `"Infinity"`...), nil
+		}
+	}
+	if o.Style == JSONAsString {
+		buf = append(buf, '"')
+		buf = d.Append(buf, 'g', -1)
+		return append(buf, '"'), nil
+	}
+	return d.Append(buf, 'g', -1), nil
+}
+
+// Unmarshal parses a JSON number or string into d.
+// As is conventional for JSON, null leaves d unchanged.
+func (o JSONOptions) Unmarshal(data []byte, d *Decimal) error {
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+	text := data
+	if len(data) > 0 && data[0] == '"' {
+		if len(data) >= 2 && data[len(data)-1] == '"' && bytes.IndexByte(data, '\\') < 0 {
+			text = data[1 : len(data)-1]
+		} else {
+			var s string
+			if err := json.Unmarshal(data, &s); err != nil {
+				return err
+			}
+			text = []byte(s)
+		}
+	}
+	return d.UnmarshalText(text)
+}
+
+// JSONNumber is a [Decimal] that encodes finite numbers as JSON numbers,
+// regardless of [DefaultJSONOptions].Style.
+// It is intended for struct fields.
+type JSONNumber Decimal
+
+// JSONString is a [Decimal] that encodes finite numbers as JSON strings,
+// regardless of [DefaultJSONOptions].Style.
+// It is intended for struct fields.
+type JSONString Decimal
+
+var _ json.Marshaler = JSONNumber{}
+var _ json.Unmarshaler = (*JSONNumber)(nil)
+var _ json.Marshaler = JSONString{}
+var _ json.Unmarshaler = (*JSONString)(nil)
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n JSONNumber) MarshalJSON() ([]byte, error) {
+	o := DefaultJSONOptions
+	o.Style = JSONAsNumber
+	return o.Marshal(Decimal(n))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *JSONNumber) UnmarshalJSON(data []byte) error {
+	return DefaultJSONOptions.Unmarshal(data, (*Decimal)(n))
+}
+
+// String returns a string representation of n.
+func (n JSONNumber) String() string {
+	return Decimal(n).String()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s JSONString) MarshalJSON() ([]byte, error) {
+	o := DefaultJSONOptions
+	o.Style = JSONAsString
+	return o.Marshal(Decimal(s))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *JSONString) UnmarshalJSON(data []byte) error {
+	return DefaultJSONOptions.Unmarshal(data, (*Decimal)(s))
+}
+
+// String returns a string representation of s.
+func (s JSONString) String() string {
+	return Decimal(s).String()
 }
@@ -27,3 +27,74 @@ func TestDecimalUnmarshalBadInputJSON(t *testing.T) {
 	var d Decimal
 	notnil(t, json.Unmarshal([]byte("omg"), &d))
 }
+
+func TestDecimalUnmarshalJSONString(t *testing.T) {
+	t.Parallel()
+
+	var d Decimal
+	isnil(t, json.Unmarshal([]byte(`"1.23"`), &d))
+	equalD64(t, MustParse("1.23"), d)
+
+	isnil(t, json.Unmarshal([]byte(`"-1.5"`), &d))
+	equalD64(t, MustParse("-1.5"), d)
+
+	isnil(t, json.Unmarshal([]byte(`"-Infinity"`), &d))
+	equal(t, NegInf, d)
+
+	isnil(t, json.Unmarshal([]byte(`null`), &d))
+	equal(t, NegInf, d)
+
+	notnil(t, json.Unmarshal([]byte(`"1.23`), &d))
+}
+
+func TestDecimalMarshalJSONNonFinite(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, o JSONOptions, d Decimal) {
+		t.Helper()
+		j, err := o.Marshal(d)
+		isnil(t, err)
+		equal(t, expected, string(j))
+		check(t, json.Valid(j))
+	}
+
+	test(`"NaN"`, JSONOptions{}, QNaN)
+	test(`"Infinity"`, JSONOptions{}, Inf)
+	test(`"-Infinity"`, JSONOptions{}, NegInf)
+	test(`null`, JSONOptions{NonFinite: NonFiniteNull}, NegInf)
+	test(`"12.5"`, JSONOptions{Style: JSONAsString}, MustParse("12.5"))
+	test(`-0`, JSONOptions{}, NegZero)
+	test(`1e-398`, JSONOptions{}, Min)
+
+	_, err := JSONOptions{NonFinite: NonFiniteError}.Marshal(QNaN)
+	notnil(t, err)
+
+	// Round-trip through the default encoding.
+	for _, d := range []Decimal{QNaN, Inf, NegInf, Max, Pi} {
+		j, err := json.Marshal(d)
+		isnil(t, err)
+		var e Decimal
+		isnil(t, json.Unmarshal(j, &e))
+		equalD64(t, d, e)
+	}
+}
+
+func TestJSONWrappers(t *testing.T) {
+	t.Parallel()
+
+	type payment struct {
+		Amount JSONString `json:"amount"`
+		Rate   JSONNumber `json:"rate"`
+	}
+
+	p := payment{JSONString(MustParse("12.50")), JSONNumber(MustParse("0.035"))}
+	j, err := json.Marshal(p)
+	isnil(t, err)
+	equal(t, `{"amount":"12.5","rate":0.035}`, string(j))
+
+	var q payment
+	isnil(t, json.Unmarshal([]byte(`{"amount":12.5,"rate":"0.035"}`), &q))
+	equal(t, p, q)
+	equal(t, "12.5", q.Amount.String())
+	equal(t, "0.035", q.Rate.String())
+}
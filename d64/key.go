@@ -0,0 +1,174 @@
+package d64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Leading bytes of keys, in ascending order.
+const (
+	keyNegQNaN byte = iota + 1
+	keyNegSNaN
+	keyNegInf
+	keyNeg
+	keyZero
+	keyPos
+	keyInf
+	keySNaN
+	keyQNaN
+)
+
+const (
+	keyFiniteLen   = 1 + 2 + 7 // tag, biased adjusted exponent, significand
+	keyNaNLen      = 1 + 7     // tag, payload
+	keyMaxAdjusted = expMax + decimalDigits - 1 + expOffset
+	keyPayloadMask = 1<<51 - 1
+	uint56Mask     = 1<<56 - 1
+)
+
+// AppendKey appends a memcomparable key for d to buf.
+//
+// Keys sort in ascending numeric order under [bytes.Compare], so range scans
+// over a key-value store can be expressed in terms of [Decimal] bounds.
+// Numbers that compare equal with [Decimal.Cmp] have identical keys, so -0
+// and 0 share a key, which decodes as 0. NaNs sort in IEEE 754 total order:
+// -NaN < -sNaN < -∞ < finite numbers < +∞ < +sNaN < +NaN, with NaNs of the
+// same kind ordered by payload.
+//
+// Keys are self-delimiting, so they may be followed by further key
+// components. Finite nonzero numbers have 10-byte keys, NaNs have 8-byte
+// keys and the rest have 1-byte keys.
+func (d Decimal) AppendKey(buf []byte) []byte {
+	var dp decParts
+	dp.unpack(d)
+	neg := dp.sign == 1
+
+	var mask uint64
+	if neg {
+		mask = ^uint64(0)
+	}
+	switch {
+	case dp.fl == flInf && neg:
+		return append(buf, keyNegInf)
+	case dp.fl == flInf:
+		return append(buf, keyInf)
+	case dp.fl == flQNaN && neg:
+		return appendUint56(append(buf, keyNegQNaN), dp.significand.lo^mask)
+	case dp.fl == flQNaN:
+		return appendUint56(append(buf, keyQNaN), dp.significand.lo)
+	case dp.fl == flSNaN && neg:
+		return appendUint56(append(buf, keyNegSNaN), dp.significand.lo^mask)
+	case dp.fl == flSNaN:
+		return appendUint56(append(buf, keySNaN), dp.significand.lo)
+	case dp.isZero():
+		return append(buf, keyZero)
+	}
+
+	// Normalize subnormals so that every number has a unique pair of
+	// adjusted exponent and 16-digit significand.
+	exp, sig := unsubnormal(dp.exp, dp.significand.lo)
+	adjusted := uint16(int(exp) + decimalDigits - 1 + expOffset)
+	if neg {
+		buf = append(buf, keyNeg)
+	} else {
+		buf = append(buf, keyPos)
+	}
+	buf = binary.BigEndian.AppendUint16(buf, adjusted^uint16(mask))
+	return appendUint56(buf, sig^mask)
+}
+
+// AppendKeyDesc appends a memcomparable key for d to buf that sorts in
+// descending numeric order. It is the bitwise complement of the key
+// appended by [Decimal.AppendKey].
+func (d Decimal) AppendKeyDesc(buf []byte) []byte {
+	n := len(buf)
+	buf = d.AppendKey(buf)
+	for i := n; i < len(buf); i++ {
+		buf[i] = ^buf[i]
+	}
+	return buf
+}
+
+func appendUint56(buf []byte, x uint64) []byte {
+	return append(buf, byte(x>>48), byte(x>>40), byte(x>>32), byte(x>>24), byte(x>>16), byte(x>>8), byte(x))
+}
+
+func uint56(b []byte) uint64 {
+	_ = b[6]
+	return uint64(b[0])<<48 | uint64(b[1])<<40 | uint64(b[2])<<32 |
+		uint64(b[3])<<24 | uint64(b[4])<<16 | uint64(b[5])<<8 | uint64(b[6])
+}
+
+// DecodeKey decodes the key at the start of b, as appended by
+// [Decimal.AppendKey], into d and returns its length in bytes.
+// On error, d is left unchanged and the length is zero.
+func (d *Decimal) DecodeKey(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, fmt.Errorf("empty key")
+	}
+	var mask uint64
+	switch tag := b[0]; tag {
+	case keyNegInf:
+		*d = NegInf
+		return 1, nil
+	case keyZero:
+		*d = Zero
+		return 1, nil
+	case keyInf:
+		*d = Inf
+		return 1, nil
+	case keyNegQNaN, keyNegSNaN, keySNaN, keyQNaN:
+		if len(b) < keyNaNLen {
+			return 0, fmt.Errorf("truncated key")
+		}
+		bits := QNaN.bits
+		if tag == keyNegSNaN || tag == keySNaN {
+			bits = SNaN.bits
+		}
+		if tag == keyNegQNaN || tag == keyNegSNaN {
+			bits |= neg
+			mask = uint56Mask
+		}
+		payload := uint56(b[1:]) ^ mask
+		if payload&^keyPayloadMask != 0 {
+			return 0, fmt.Errorf("invalid key NaN payload")
+		}
+		*d = newDec(bits | payload)
+		return keyNaNLen, nil
+	case keyNeg, keyPos:
+		if len(b) < keyFiniteLen {
+			return 0, fmt.Errorf("truncated key")
+		}
+		var sign int8
+		if tag == keyNeg {
+			sign = 1
+			mask = uint56Mask
+		}
+		adjusted := binary.BigEndian.Uint16(b[1:]) ^ uint16(mask)
+		sig := uint56(b[3:]) ^ mask
+		if adjusted > keyMaxAdjusted || sig < decimalBase || sig > maxSig {
+			return 0, fmt.Errorf("invalid key")
+		}
+		exp := int(adjusted) - expOffset - (decimalDigits - 1)
+		e, err := DefaultContext.newFromCoefficient(sign, exp, uint128T{lo: sig}, false)
+		if err != nil {
+			return 0, fmt.Errorf("invalid key: %w", err)
+		}
+		*d = e
+		return keyFiniteLen, nil
+	default:
+		return 0, fmt.Errorf("invalid key tag 0x%02x", tag)
+	}
+}
+
+// DecodeKeyDesc decodes the key at the start of b, as appended by
+// [Decimal.AppendKeyDesc], into d and returns its length in bytes.
+// On error, d is left unchanged and the length is zero.
+func (d *Decimal) DecodeKeyDesc(b []byte) (int, error) {
+	var key [keyFiniteLen]byte
+	n := copy(key[:], b)
+	for i := range key[:n] {
+		key[i] = ^key[i]
+	}
+	return d.DecodeKey(key[:n])
+}
@@ -0,0 +1,131 @@
+package d64
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func keyOrdered() []Decimal {
+	return []Decimal{
+		newDec(neg | QNaN.bits | 2),
+		newDec(neg | QNaN.bits | 1),
+		newDec(neg | QNaN.bits),
+		newDec(neg | SNaN.bits | 1),
+		newDec(neg | SNaN.bits),
+		NegInf,
+		NegMax,
+		MustParse("-1e300"),
+		MustParse("-12345"),
+		MustParse("-1.0001"),
+		NegOne,
+		MustParse("-0.99999"),
+		MustParse("-1e-300"),
+		MustParse("-2e-398"),
+		NegMin,
+		Zero,
+		Min,
+		MustParse("1.2e-397"),
+		MustParse("1e-383"),
+		MustParse("0.001"),
+		MustParse("0.1"),
+		One,
+		MustParse("1.000000000000001"),
+		MustParse("9.999999999999999"),
+		NewFromInt64(10),
+		MustParse("1e16"),
+		Max,
+		Inf,
+		SNaN,
+		newDec(SNaN.bits | 1),
+		QNaN,
+		newDec(QNaN.bits | 1),
+		newDec(QNaN.bits | 1<<50),
+	}
+}
+
+func TestDecimalAppendKey(t *testing.T) {
+	t.Parallel()
+
+	ordered := keyOrdered()
+	for i, d := range ordered {
+		key := d.AppendKey([]byte{0xff})[1:]
+		desc := d.AppendKeyDesc(nil)
+
+		var e Decimal
+		n, err := e.DecodeKey(append(key, 0xab))
+		isnil(t, err)
+		equal(t, len(key), n)
+		equal(t, d.bits, e.bits)
+
+		n, err = e.DecodeKeyDesc(append(desc, 0xab))
+		isnil(t, err)
+		equal(t, len(desc), n)
+		equal(t, d.bits, e.bits)
+
+		for j, f := range ordered[:i] {
+			check(t, bytes.Compare(f.AppendKey(nil), key) < 0).Or(func() {
+				t.Errorf("key(%v) ≮ key(%v) (%d, %d)", f, d, j, i)
+			})
+			check(t, bytes.Compare(f.AppendKeyDesc(nil), desc) > 0)
+		}
+	}
+
+	equal(t, string(Zero.AppendKey(nil)), string(NegZero.AppendKey(nil)))
+}
+
+func TestDecimalAppendKeyMatchesCmp(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(0))
+	random := func() Decimal {
+		sig := r.Int63n(int64(maxSig) + 1)
+		if r.Intn(4) == 0 {
+			sig %= 1000
+		}
+		d := newFromParts(int8(r.Intn(2)), int16(r.Intn(768)-expOffset), uint64(sig))
+		return d.Add(Zero)
+	}
+
+	for i := 0; i < 10000; i++ {
+		d, e := random(), random()
+		if i%10 == 0 {
+			e = d.Neg()
+		}
+		equal(t, d.Cmp(e), bytes.Compare(d.AppendKey(nil), e.AppendKey(nil))).Or(func() {
+			t.Errorf("d = %v, e = %v", d, e)
+		})
+		equal(t, -d.Cmp(e), bytes.Compare(d.AppendKeyDesc(nil), e.AppendKeyDesc(nil)))
+	}
+}
+
+func TestDecimalDecodeKey(t *testing.T) {
+	t.Parallel()
+
+	invalid := func(b ...byte) {
+		t.Helper()
+		d := Pi
+		n, err := d.DecodeKey(b)
+		notnil(t, err)
+		equal(t, 0, n)
+		equalD64(t, Pi, d)
+	}
+
+	invalid()
+	invalid(0)
+	invalid(10)
+	invalid(keyPos, 0, 0, 0, 0, 0, 0, 0, 0)
+	invalid(keyQNaN, 0, 0, 0)
+	invalid(keyQNaN, 0xff, 0, 0, 0, 0, 0, 0)
+	// Significand below 10¹⁵.
+	invalid(keyPos, 0x01, 0x8d, 0, 0, 0, 0, 0, 0, 1)
+	// Exponent above the maximum.
+	invalid(append([]byte{keyPos, 0x03, 0x0f}, One.AppendKey(nil)[3:]...)...)
+	// Subnormal with digits below 10⁻³⁹⁸.
+	invalid(append([]byte{keyPos, 0, 0}, MustParse("1.5").AppendKey(nil)[3:]...)...)
+
+	var d Decimal
+	n, err := d.DecodeKeyDesc(nil)
+	notnil(t, err)
+	equal(t, 0, n)
+}
@@ -0,0 +1,152 @@
+package d64
+
+import (
+	"bytes"
+	"strings"
+)
+
+// NumberFormat formats numbers for display with locale-specific separators
+// and grouping. The zero value formats like [Decimal.Append] with the 'f'
+// format.
+type NumberFormat struct {
+	// DecimalSep separates the integer and fractional parts. If it is
+	// empty, "." is used.
+	DecimalSep string
+
+	// GroupSep separates groups of integer digits.
+	GroupSep string
+
+	// GroupSize is the number of digits in the group nearest the decimal
+	// separator. If it is zero, digits aren't grouped.
+	GroupSize int
+
+	// SecondaryGroupSize is the number of digits in the other groups, as
+	// in Indian grouping, which has a primary group size of 3 and a
+	// secondary group size of 2. If it is zero, GroupSize is used.
+	SecondaryGroupSize int
+
+	// Minus precedes negative numbers. If it is empty, "-" is used.
+	Minus string
+
+	// Plus precedes numbers that aren't negative.
+	Plus string
+}
+
+// Common number formats. Spaces are no-break spaces, as in CLDR.
+var (
+	commaPoint  = NumberFormat{DecimalSep: ".", GroupSep: ",", GroupSize: 3}
+	pointComma  = NumberFormat{DecimalSep: ",", GroupSep: ".", GroupSize: 3}
+	spaceComma  = NumberFormat{DecimalSep: ",", GroupSep: "\u00a0", GroupSize: 3}
+	narrowComma = NumberFormat{DecimalSep: ",", GroupSep: "\u202f", GroupSize: 3}
+	indian      = NumberFormat{DecimalSep: ".", GroupSep: ",", GroupSize: 3, SecondaryGroupSize: 2}
+	apostrophe  = NumberFormat{DecimalSep: ".", GroupSep: "’", GroupSize: 3}
+)
+
+// localeFormats maps locale tags and languages to number formats.
+var localeFormats = map[string]NumberFormat{
+	"en":    commaPoint,
+	"en-IN": indian,
+	"hi":    indian,
+	"ja":    commaPoint,
+	"zh":    commaPoint,
+	"ko":    commaPoint,
+	"th":    commaPoint,
+	"de":    pointComma,
+	"de-CH": apostrophe,
+	"es":    pointComma,
+	"id":    pointComma,
+	"it":    pointComma,
+	"nl":    pointComma,
+	"pt":    pointComma,
+	"tr":    pointComma,
+	"vi":    pointComma,
+	"fr":    narrowComma,
+	"cs":    spaceComma,
+	"fi":    spaceComma,
+	"nb":    spaceComma,
+	"pl":    spaceComma,
+	"ru":    spaceComma,
+	"sv":    spaceComma,
+	"uk":    spaceComma,
+}
+
+// LocaleFormat returns the number format for a BCP 47 locale tag, such as
+// "en-US", "de" or "en-IN". It tries the language and region before the
+// language alone, and reports false if it knows neither.
+func LocaleFormat(locale string) (NumberFormat, bool) {
+	locale = strings.ReplaceAll(locale, "_", "-")
+	lang, rest, _ := strings.Cut(locale, "-")
+	lang = strings.ToLower(lang)
+	if region, _, _ := strings.Cut(rest, "-"); region != "" {
+		if f, ok := localeFormats[lang+"-"+strings.ToUpper(region)]; ok {
+			return f, true
+		}
+	}
+	f, ok := localeFormats[lang]
+	return f, ok
+}
+
+// Format returns d formatted with f and prec digits after the decimal
+// separator, or the fewest digits necessary to represent d exactly if prec
+// is negative.
+// It uses [DefaultFormatContext] to round.
+func (f NumberFormat) Format(d Decimal, prec int) string {
+	var buf [64]byte
+	return string(f.Append(buf[:0], d, prec))
+}
+
+// Append appends d formatted as for [NumberFormat.Format] to buf.
+// It doesn't allocate unless buf needs to grow.
+func (f NumberFormat) Append(buf []byte, d Decimal, prec int) []byte {
+	var tmp [64]byte
+	text := DefaultFormatContext.append(d, tmp[:0], -1, prec, noFlags, 'f')
+	if text[0] == '-' {
+		text = text[1:]
+		if f.Minus == "" {
+			buf = append(buf, '-')
+		} else {
+			buf = append(buf, f.Minus...)
+		}
+	} else {
+		buf = append(buf, f.Plus...)
+	}
+	if !d.isFinite() {
+		return append(buf, text...)
+	}
+
+	whole, frac, hasFrac := bytes.Cut(text, []byte{'.'})
+	buf = f.appendGrouped(buf, whole)
+	if hasFrac {
+		if f.DecimalSep == "" {
+			buf = append(buf, '.')
+		} else {
+			buf = append(buf, f.DecimalSep...)
+		}
+		buf = append(buf, frac...)
+	}
+	return buf
+}
+
+// appendGrouped appends the integer digits to buf with group separators.
+func (f NumberFormat) appendGrouped(buf, digits []byte) []byte {
+	primary, secondary := f.GroupSize, f.SecondaryGroupSize
+	if primary <= 0 || len(digits) <= primary {
+		return append(buf, digits...)
+	}
+	if secondary <= 0 {
+		secondary = primary
+	}
+	head := (len(digits) - primary) % secondary
+	if head == 0 {
+		head = secondary
+	}
+	buf = append(buf, digits[:head]...)
+	digits = digits[head:]
+	for len(digits) > primary {
+		buf = append(buf, f.GroupSep...)
+		buf = append(buf, digits[:secondary]...)
+		digits = digits[secondary:]
+	}
+	buf = append(buf, f.GroupSep...)
+	return append(buf, digits...)
+}
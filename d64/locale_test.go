@@ -0,0 +1,92 @@
+package d64
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestNumberFormat(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, f NumberFormat, d string, prec int) {
+		t.Helper()
+		equal(t, expected, f.Format(MustParse(d), prec))
+		equal(t, "x"+expected, string(f.Append([]byte("x"), MustParse(d), prec)))
+	}
+
+	en, _ := LocaleFormat("en-US")
+	de, _ := LocaleFormat("de-DE")
+	in, _ := LocaleFormat("en_IN")
+	fr, _ := LocaleFormat("fr")
+	ch, _ := LocaleFormat("de-CH")
+
+	test("1,234,567.89", en, "1234567.89", 2)
+	test("1.234.567,89", de, "1234567.89", 2)
+	test("12,34,567.89", in, "1234567.89", 2)
+	test("1\u202f234\u202f567,89", fr, "1234567.89", 2)
+	test("1’234’567.89", ch, "1234567.89", 2)
+	test("1 234 567,89", NumberFormat{DecimalSep: ",", GroupSep: " ", GroupSize: 3}, "1234567.89", -1)
+
+	test("0.00", en, "0", 2)
+	test("999", en, "999", -1)
+	test("1,000", en, "1000", -1)
+	test("-1,000.5", en, "-1000.5", -1)
+	test("1,000.50", en, "1000.499", 2)
+	test("1,000,000,000,000,000,000,000", en, "1e21", -1)
+	test("0.000123", en, "1.23e-4", -1)
+	test("1,00,00,000", in, "1e7", 0)
+	test("1,23,45,67,890", in, "1234567890", -1)
+
+	test("1234567.89", NumberFormat{}, "1234567.89", -1)
+	test("\u221212,3", NumberFormat{DecimalSep: ",", Minus: "\u2212"}, "-12.3", -1)
+	test("+12.3", NumberFormat{Plus: "+"}, "12.3", -1)
+	test("1-2345-6789", NumberFormat{GroupSep: "-", GroupSize: 4}, "123456789", -1)
+
+	test("NaN", de, "NaN", 2)
+	test("inf", de, "inf", 2)
+	test("-inf", de, "-inf", 2)
+}
+
+func TestLocaleFormat(t *testing.T) {
+	t.Parallel()
+
+	test := func(locale, decimalSep, groupSep string) {
+		t.Helper()
+		f, ok := LocaleFormat(locale)
+		check(t, ok)
+		equal(t, decimalSep, f.DecimalSep)
+		equal(t, groupSep, f.GroupSep)
+	}
+	test("en", ".", ",")
+	test("EN-gb", ".", ",")
+	test("de-AT", ",", ".")
+	test("pt-BR", ",", ".")
+	test("sv-SE", ",", "\u00a0")
+	test("hi-IN", ".", ",")
+
+	_, ok := LocaleFormat("xx")
+	equal(t, false, ok)
+	_, ok = LocaleFormat("")
+	equal(t, false, ok)
+}
+
+func TestNumberFormatAllocs(t *testing.T) {
+	if unsafe.Sizeof(Zero) != unsafe.Sizeof(uint64(0)) {
+		t.Skip("decimal_debug builds allocate debug strings")
+	}
+	f, _ := LocaleFormat("en-IN")
+	d := MustParse("-1234567.891")
+	var buf [32]byte
+	equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		_ = f.Append(buf[:0], d, 2)
+	}))
+}
+
+func BenchmarkNumberFormatAppend(b *testing.B) {
+	f, _ := LocaleFormat("de")
+	d := MustParse("-1234567.891")
+	var buf [32]byte
+	for n := 0; n < b.N; n++ {
+		_ = f.Append(buf[:0], d, 2)
+	}
+}
@@ -1,10 +1,8 @@
 package d64
 
 import (
-	"bytes"
 	"encoding"
 	"encoding/binary"
-	"fmt"
 )
 
 var _ encoding.TextMarshaler = Zero
@@ -17,17 +15,10 @@ func (d Decimal) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 func (d *Decimal) UnmarshalText(text []byte) error {
-	state := &scanner{reader: bytes.NewReader(text)}
-	var e Decimal
-	if err := DefaultContext.Scan(&e, state, 'e'); err != nil {
+	e, err := parseText(DefaultContext, ParseOptions{}, text, "UnmarshalText")
+	if err != nil {
 		return err
 	}
-
-	r, _, err := state.ReadRune()
-	if err == nil {
-		return fmt.Errorf("expected end of text, found %c", r)
-	}
-
 	*d = e
 	return nil
 }
@@ -37,9 +28,13 @@ var _ encoding.BinaryUnmarshaler = (*Decimal)(nil)
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
 func (d Decimal) MarshalBinary() ([]byte, error) {
-	buf := make([]byte, 8)
-	binary.BigEndian.PutUint64(buf, d.bits)
-	return buf, nil
+	return d.AppendBinary(make([]byte, 0, 8))
+}
+
+// AppendBinary appends the binary encoding of d, as returned by
+// [Decimal.MarshalBinary], to buf. It never returns an error.
+func (d Decimal) AppendBinary(buf []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(buf, d.bits), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
@@ -24,3 +24,19 @@ func TestDecimalUnmarshalBadInput(t *testing.T) {
 	var d Decimal
 	notnil(t, d.UnmarshalText([]byte("omg")))
 }
+
+func TestDecimalMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	data, err := One.MarshalBinary()
+	isnil(t, err)
+	equal(t, "\x2f\xe3\x8d\x7e\xa4\xc6\x80\x00", string(data))
+
+	data, err = One.AppendBinary([]byte("x"))
+	isnil(t, err)
+	equal(t, "x\x2f\xe3\x8d\x7e\xa4\xc6\x80\x00", string(data))
+
+	var d Decimal
+	isnil(t, d.UnmarshalBinary(data[1:]))
+	equalD64(t, One, d)
+}
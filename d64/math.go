@@ -87,6 +87,9 @@ func cmp(d, e Decimal, dp, ep *decParts) int {
 		return 0
 	default:
 		diff := d.Sub(e)
+		if diff.IsZero() {
+			return 0
+		}
 		return 1 - 2*int(diff.bits>>63)
 	}
 }
@@ -112,10 +115,18 @@ func (d Decimal) min(e Decimal, sign int) Decimal {
 
 	switch {
 	case !dnan && !enan: // Fast path for non-NaNs.
-		if sign*cmp(d, e, &dp, &ep) < 0 {
+		switch sign * cmp(d, e, &dp, &ep) {
+		case -1:
 			return d
+		case 1:
+			return e
+		default:
+			// Equal values may still differ in sign, as -0 and 0 do.
+			if 2*int(d.bits>>63) == 1+sign {
+				return d
+			}
+			return e
 		}
-		return e
 
 	case dp.fl == flSNaN:
 		return d.quiet()
@@ -187,7 +198,7 @@ func (d Decimal) Logb() Decimal {
 	fl := d.flavor()
 	switch {
 	case fl.nan():
-		return d
+		return d.qNan()
 	case d.IsZero():
 		return NegInf
 	case fl == flInf:
@@ -289,7 +300,7 @@ func (d Decimal) Sqrt() Decimal {
 	case flQNaN:
 		return d
 	case flSNaN:
-		return SNaN
+		return d.qNan()
 	case flNormal53, flNormal51:
 	}
 	if significand == 0 {
@@ -341,6 +352,10 @@ func (ctx Context) add(d, e Decimal, dp, ep *decParts) Decimal {
 		}
 	}
 	if dp.significand.lo == 0 {
+		if ep.significand.lo == 0 {
+			// Zeroes of opposite sign sum to +0.
+			return zeroes[dp.sign&ep.sign]
+		}
 		return e
 	} else if ep.significand.lo == 0 {
 		return d
@@ -348,6 +363,8 @@ func (ctx Context) add(d, e Decimal, dp, ep *decParts) Decimal {
 
 	var ans decParts
 
+	// The operands are rescaled below, so take the preferred exponent first.
+	prefexp := min(dp.exp, ep.exp)
 	sep := dp.exp - ep.exp
 	switch {
 	case sep < -17:
@@ -378,9 +395,13 @@ func (ctx Context) add(d, e Decimal, dp, ep *decParts) Decimal {
 			rndStatus = ans.rescale(-expOffset)
 		}
 		ans.significand.lo = ctx.Rounding.round(ans.significand.lo, rndStatus)
+		if ans.significand.lo > maxSig {
+			// Rounding carried into a 17th digit, which is always zero.
+			ans.significand.lo /= 10
+			ans.exp++
+		}
 	}
 
-	prefexp := min(dp.exp, ep.exp)
 	// TODO: replace O(n) loops with O(1) or O(log n) rescaling.
 	for ans.exp < prefexp && ans.significand.lo%10 == 0 {
 		ans.significand.lo /= 10
@@ -399,9 +420,9 @@ func (ctx Context) add(d, e Decimal, dp, ep *decParts) Decimal {
 	return ans.decimal()
 }
 
-// Add computes d + e
+// Sub computes d - e
 func (ctx Context) Sub(d, e Decimal) Decimal {
-	return d.Add(newDec(neg ^ e.bits))
+	return ctx.Add(d, e.Neg())
 }
 
 // FMA computes d*e + f
@@ -421,12 +442,16 @@ func (ctx Context) FMA(d, e, f Decimal) Decimal {
 		}
 		return infinities[ans.sign]
 	}
-	if ep.significand.lo == 0 || dp.significand.lo == 0 {
-		return f
-	}
 	if fp.fl == flInf {
 		return infinities[fp.sign]
 	}
+	if ep.significand.lo == 0 || dp.significand.lo == 0 {
+		if fp.significand.lo == 0 {
+			// Zeroes of opposite sign sum to +0.
+			return zeroes[ans.sign&fp.sign]
+		}
+		return f
+	}
 
 	var rndStatus discardedDigit
 	ep.removeZeros()
@@ -502,7 +527,7 @@ func (d Decimal) NextPlus() Decimal {
 		}
 		return Inf
 	case !flav.normal():
-		return d
+		return d.qNan()
 	case significand == 0:
 		return Min
 	case sign == 1:
@@ -513,7 +538,7 @@ func (d Decimal) NextPlus() Decimal {
 			if significand > 1 {
 				return newDec(d.bits - 1)
 			}
-			return Zero
+			return NegZero
 		default:
 			return newFromParts(sign, exp-1, 10*decimalBase-1)
 		}
@@ -539,7 +564,7 @@ func (d Decimal) NextMinus() Decimal {
 		}
 		return NegInf
 	case !flav.normal():
-		return d
+		return d.qNan()
 	case significand == 0:
 		return NegMin
 	case sign == 0:
@@ -585,7 +610,10 @@ func (ctx Context) roundRaw(d, e Decimal) Decimal {
 }
 
 var (
-	zeroRaw = newNostr(newFromPartsRaw(0, 0, 0).bits)
+	zeroesRaw = [2]Decimal{
+		newNostr(newFromPartsRaw(0, 0, 0).bits),
+		newNostr(newFromPartsRaw(1, 0, 0).bits),
+	}
 	qNaNRaw = newNostr(0x7c << 56)
 )
 
@@ -605,7 +633,7 @@ func (ctx Context) roundRefRaw(d, e Decimal, dp, ep *decParts) Decimal {
 
 	delta := dexp - eexp
 	if delta < -1 { // -1 avoids rounding range
-		return zeroRaw
+		return zeroesRaw[dp.sign]
 	}
 	if delta > 14 {
 		return d
@@ -633,6 +661,9 @@ var decPartsOne decParts = unpack(One)
 func (ctx Context) ToIntegral(d Decimal) Decimal {
 	var dp decParts
 	dp.unpack(d)
+	if dp.fl == flSNaN {
+		return d.qNan()
+	}
 	if !dp.fl.normal() || dp.exp >= 0 {
 		return d
 	}
@@ -122,6 +122,19 @@ func TestDecimalCmp(t *testing.T) {
 	equal(t, 1, One.Cmp(NegOne))
 	equal(t, 1, One.Cmp(Zero))
 	equal(t, 1, One.Cmp(NegZero))
+
+	// Equal values with different exponents.
+	for _, d := range []Decimal{One, NegOne, MustParse("123.45"), MustParse("-1e-390")} {
+		_, sign, exp, significand := d.parts()
+		for significand%10 == 0 {
+			significand /= 10
+			exp++
+			e := newDec(newFromPartsRaw(sign, exp, significand).bits)
+			equal(t, 0, d.Cmp(e))
+			equal(t, 0, e.Cmp(d))
+			check(t, d.Equal(e))
+		}
+	}
 }
 
 func TestDecimalCmpNaN(t *testing.T) {
@@ -602,6 +615,67 @@ func TestAddOverflow(t *testing.T) {
 	equal(t, Inf, Max.Add(MustParse("0.000000000000001e384")))
 	equal(t, Max, Max.Add(MustParse("1")))
 	equal(t, Max, Zero.Add(Max))
+
+	// Rounding carries into a 17th digit.
+	equalD64(t, MustParse("1e16"), MustParse("9999999999999999").Add(MustParse("0.5")))
+	equalD64(t, MustParse("-1e16"), MustParse("-9999999999999999").Sub(MustParse("0.9")))
+	equal(t, Inf, MustParse("9.999999999999999e384").Add(MustParse("5e368")))
+	equalD64(t, MustParse("1.000000000000000e-382"), MustParse("9999999999999999e-398").Add(MustParse("9e-399")))
+}
+
+func TestAddSubnormalEncodings(t *testing.T) {
+	t.Parallel()
+
+	// 1e-390 as 10000e-394 rather than 100000000e-398.
+	d := newDec(newFromPartsRaw(0, -394, 10000).bits)
+	tiny := MustParse("1e-398")
+	equalD64(t, MustParse("1.00000001e-390"), d.Add(tiny))
+	equalD64(t, MustParse("1.00000001e-390"), tiny.Add(d))
+	equalD64(t, MustParse("9.9999999e-391"), d.Sub(tiny))
+	check(t, d.Sub(MustParse("1e-390")).IsZero())
+}
+
+func TestZeroSigns(t *testing.T) {
+	t.Parallel()
+
+	sign := func(d Decimal) string {
+		check(t, d.IsZero()).Or(func() { t.Errorf("%v isn't zero", d) })
+		return d.SciString()
+	}
+
+	equal(t, "0", sign(NegZero.Add(Zero)))
+	equal(t, "-0", sign(NegZero.Add(NegZero)))
+	equal(t, "0", sign(Zero.Sub(Zero)))
+	equal(t, "-0", sign(NegZero.Sub(Zero)))
+	equal(t, "0", sign(NegOne.Add(One)))
+	equal(t, "0", sign(MustParse("-1.5").Sub(MustParse("-1.5"))))
+	equal(t, "0", sign(DefaultContext.FMA(NegOne, One, One)))
+	equal(t, "0", sign(DefaultContext.FMA(NegOne, Zero, Zero)))
+	equal(t, "-0", sign(DefaultContext.FMA(NegOne, Zero, NegZero)))
+	equal(t, "-0", sign(DefaultContext.FMA(MustParse("1e-277"), MustParse("-1e-311"), Zero)))
+
+	equal(t, "-0", sign(NegZero.Min(Zero)))
+	equal(t, "-0", sign(Zero.Min(NegZero)))
+	equal(t, "0", sign(NegZero.Max(Zero)))
+	equal(t, "0", sign(Zero.Max(NegZero)))
+
+	equal(t, "-0", sign(MustParse("-0.1").Round(MustParse("1e2"))))
+	equal(t, "-0", sign(MustParse("-0.0000056267").ToIntegral()))
+	equal(t, "-0", sign(MustParse("-1e-398").NextPlus()))
+	equal(t, "0", sign(MustParse("1e-398").NextMinus()))
+}
+
+func TestQuietSNaN(t *testing.T) {
+	t.Parallel()
+
+	snan := MustParse("-sNaN42")
+	equal(t, "-NaN42", snan.Logb().SciString())
+	equal(t, "-NaN42", snan.Sqrt().SciString())
+	equal(t, "-NaN42", snan.NextPlus().SciString())
+	equal(t, "-NaN42", snan.NextMinus().SciString())
+	equal(t, "-NaN42", snan.ToIntegral().SciString())
+	equal(t, "-NaN42", Zero.Add(snan).SciString())
+	equal(t, "-NaN42", Zero.Sub(snan).SciString())
 }
 
 func TestQuoOverflow(t *testing.T) {
@@ -0,0 +1,47 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	test := func(code Code, number, minor int) {
+		t.Helper()
+		c, ok := Lookup(code)
+		expect.Equal(t, true, ok)
+		expect.Equal(t, Currency{code, number, minor}, c)
+		m, ok := code.MinorUnits()
+		expect.Equal(t, true, ok)
+		expect.Equal(t, minor, m)
+	}
+	test("AUD", 36, 2)
+	test("USD", 840, 2)
+	test("EUR", 978, 2)
+	test("JPY", 392, 0)
+	test("KRW", 410, 0)
+	test("BHD", 48, 3)
+	test("KWD", 414, 3)
+	test("CLF", 990, 4)
+	test("ALL", 8, 2)
+
+	for _, code := range []Code{"", "aud", "XAU", "XXX", "ABC", "AUDD"} {
+		_, ok := Lookup(code)
+		expect.Equal(t, false, ok)
+	}
+}
+
+func TestCurrencyTable(t *testing.T) {
+	t.Parallel()
+
+	expect.Equal(t, len(iso4217), len(currencies))
+	for _, c := range iso4217 {
+		expect.Equal(t, 3, len(c.Code))
+		if c.Number <= 0 || c.Number > 999 || c.MinorUnits < 0 || c.MinorUnits > 4 {
+			t.Errorf("bad entry %+v", c)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anz-bank/decimal/d64"
+)
+
+var _ json.Marshaler = Amount{}
+var _ json.Unmarshaler = (*Amount)(nil)
+
+// MarshalJSON implements the json.Marshaler interface. It encodes a as an
+// object with the value as a number and the currency code as a string, e.g.,
+// {"value":12.30,"currency":"AUD"}. NaN and ±∞ are encoded as for
+// [d64.DefaultJSONOptions]. It reports [ErrUnknownCurrency] if the currency
+// isn't known to [Lookup], as for the zero Amount, since [Amount.UnmarshalJSON]
+// would reject the result.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	if _, ok := Lookup(a.Currency); !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCurrency, a.Currency)
+	}
+	buf := append(make([]byte, 0, 48), `{"value":`...)
+	if a.Value.IsNaN() || a.Value.IsInf() {
+		var err error
+		if buf, err = d64.DefaultJSONOptions.Append(buf, a.Value); err != nil {
+			return nil, err
+		}
+	} else {
+		buf = a.appendValue(buf)
+	}
+	buf = append(buf, `,"currency":`...)
+	code, err := json.Marshal(string(a.Currency))
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, code...)
+	return append(buf, '}'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts the
+// value as a number or a string, and reports an error if it is missing or
+// null, and [ErrUnknownCurrency] if the currency isn't known to [Lookup]. As
+// usual, a JSON null leaves a unchanged.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v struct {
+		Value    json.RawMessage `json:"value"`
+		Currency Code            `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if len(v.Value) == 0 || string(v.Value) == "null" {
+		return fmt.Errorf("money: amount %s has no value", data)
+	}
+	var value d64.Decimal
+	if err := value.UnmarshalJSON(v.Value); err != nil {
+		return err
+	}
+	if _, ok := Lookup(v.Currency); !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCurrency, v.Currency)
+	}
+	*a = Amount{value, v.Currency}
+	return nil
+}
@@ -0,0 +1,67 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/anz-bank/decimal/d64"
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func TestAmountJSON(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, a Amount) {
+		t.Helper()
+		data, err := json.Marshal(a)
+		expect.Nil(t, err)
+		expect.Equal(t, expected, string(data))
+
+		var b Amount
+		expect.Nil(t, json.Unmarshal(data, &b))
+		expect.Equal(t, a.String(), b.String())
+	}
+	test(`{"value":12.30,"currency":"AUD"}`, aud("12.3"))
+	test(`{"value":-0.005,"currency":"AUD"}`, aud("-0.005"))
+	test(`{"value":1000,"currency":"JPY"}`, MustNew(d64.MustParse("1000"), "JPY"))
+	test(`{"value":"NaN","currency":"AUD"}`, aud("NaN"))
+	test(`{"value":"-Infinity","currency":"AUD"}`, aud("-inf"))
+
+	var a Amount
+	expect.Nil(t, json.Unmarshal([]byte(`{"currency":"USD","value":"1.5"}`), &a))
+	same(t, MustNew(d64.MustParse("1.5"), "USD"), a)
+
+	expect.ErrorIs(t, json.Unmarshal([]byte(`{"value":1,"currency":"ZZZ"}`), &a), ErrUnknownCurrency)
+	for _, data := range []string{
+		`{"value":"x","currency":"AUD"}`, `{"currency":"AUD"}`, `{"value":null,"currency":"AUD"}`,
+	} {
+		if json.Unmarshal([]byte(data), &a) == nil {
+			t.Errorf("expected error for %s", data)
+		}
+	}
+
+	// null leaves the amount unchanged.
+	expect.Nil(t, json.Unmarshal([]byte(`null`), &a))
+	same(t, MustNew(d64.MustParse("1.5"), "USD"), a)
+}
+
+func TestAmountJSONUnknownCurrency(t *testing.T) {
+	t.Parallel()
+
+	// The zero Amount has no currency, so it doesn't round-trip.
+	_, err := json.Marshal(Amount{})
+	expect.ErrorIs(t, err, ErrUnknownCurrency)
+	_, err = json.Marshal(Amount{d64.One, "ZZZ"})
+	expect.ErrorIs(t, err, ErrUnknownCurrency)
+
+	var a Amount
+	expect.ErrorIs(t, json.Unmarshal([]byte(`{"value":0,"currency":""}`), &a), ErrUnknownCurrency)
+
+	// A pointer to an Amount round-trips through null.
+	var p *Amount
+	data, err := json.Marshal(p)
+	expect.Nil(t, err)
+	expect.Equal(t, "null", string(data))
+	expect.Nil(t, json.Unmarshal(data, &p))
+	expect.Equal(t, (*Amount)(nil), p)
+}
@@ -0,0 +1,196 @@
+// Package money represents amounts of money in ISO 4217 currencies as
+// [d64.Decimal] values tagged with their currency.
+//
+// Arithmetic on an [Amount] refuses to mix currencies, and
+// [Amount.Quantize] rounds to the minor unit of the currency, such as cents
+// for AUD, with a chosen [d64.Rounding]. The currencies and their minor
+// units come from a table of ISO 4217 currencies embedded in the package;
+// see [Lookup].
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/anz-bank/decimal/d64"
+)
+
+var (
+	// ErrCurrencyMismatch is reported when amounts in different currencies
+	// are combined or compared.
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+
+	// ErrUnknownCurrency is reported for currency codes unknown to [Lookup].
+	ErrUnknownCurrency = errors.New("money: unknown currency")
+)
+
+// Amount is an amount of money in a currency.
+type Amount struct {
+	Value    d64.Decimal
+	Currency Code
+}
+
+// New returns an amount of value in the currency with the given code. It
+// reports [ErrUnknownCurrency] if the code isn't known to [Lookup]. The value
+// isn't quantized; see [Amount.Quantize].
+func New(value d64.Decimal, code Code) (Amount, error) {
+	if _, ok := Lookup(code); !ok {
+		return Amount{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+	return Amount{value, code}, nil
+}
+
+// MustNew is like [New] but panics if the code isn't known.
+func MustNew(value d64.Decimal, code Code) Amount {
+	a, err := New(value, code)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// FromMinorUnits returns an amount of n minor units of a currency, e.g.,
+// 1234 cents as AUD 12.34.
+func FromMinorUnits(n int64, code Code) (Amount, error) {
+	c, ok := Lookup(code)
+	if !ok {
+		return Amount{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+	value, err := d64.NewFromUnscaled64(n, c.MinorUnits)
+	if err != nil {
+		return Amount{}, fmt.Errorf("money: %d minor units of %s: %w", n, code, err)
+	}
+	return Amount{value, code}, nil
+}
+
+// Parse parses an amount written as a currency code and a number separated
+// by a space, in either order, e.g., "AUD 12.34" or "12.34 AUD".
+func Parse(s string) (Amount, error) {
+	first, second, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return Amount{}, fmt.Errorf("money: parsing %q: missing currency", s)
+	}
+	code, number := first, strings.TrimSpace(second)
+	if _, known := Lookup(Code(code)); !known {
+		code, number = number, first
+	}
+	value, err := d64.Parse(number)
+	if err != nil {
+		return Amount{}, fmt.Errorf("money: parsing %q: %w", s, err)
+	}
+	return New(value, Code(code))
+}
+
+// Add returns a + b. It reports [ErrCurrencyMismatch] if a and b have
+// different currencies.
+func (a Amount) Add(b Amount) (Amount, error) {
+	if err := a.check(b); err != nil {
+		return Amount{}, err
+	}
+	return Amount{a.Value.Add(b.Value), a.Currency}, nil
+}
+
+// Sub returns a - b. It reports [ErrCurrencyMismatch] if a and b have
+// different currencies.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if err := a.check(b); err != nil {
+		return Amount{}, err
+	}
+	return Amount{a.Value.Sub(b.Value), a.Currency}, nil
+}
+
+// Mul returns a × d, such as a price times a quantity or an interest rate.
+// The result isn't quantized; see [Amount.Quantize].
+func (a Amount) Mul(d d64.Decimal) Amount {
+	return Amount{a.Value.Mul(d), a.Currency}
+}
+
+// Neg returns -a.
+func (a Amount) Neg() Amount {
+	return Amount{a.Value.Neg(), a.Currency}
+}
+
+// Abs returns |a|.
+func (a Amount) Abs() Amount {
+	return Amount{a.Value.Abs(), a.Currency}
+}
+
+// Cmp compares a and b as for [d64.Decimal.Cmp]. It reports
+// [ErrCurrencyMismatch] if a and b have different currencies.
+func (a Amount) Cmp(b Amount) (int, error) {
+	if err := a.check(b); err != nil {
+		return 0, err
+	}
+	return a.Value.Cmp(b.Value), nil
+}
+
+// IsZero reports whether a is zero in any currency.
+func (a Amount) IsZero() bool {
+	return a.Value.IsZero()
+}
+
+func (a Amount) check(b Amount) error {
+	if a.Currency != b.Currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.Currency, b.Currency)
+	}
+	return nil
+}
+
+// Quantize rounds a to the minor unit of its currency, e.g., to cents for
+// AUD or to whole yen for JPY, with the given rounding. It reports
+// [ErrUnknownCurrency] if a's currency isn't known to [Lookup].
+func (a Amount) Quantize(r d64.Rounding) (Amount, error) {
+	unit, err := a.minorUnit()
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{d64.Context{Rounding: r}.Round(a.Value, unit), a.Currency}, nil
+}
+
+// MinorUnits returns a as a whole number of minor units of its currency,
+// e.g., 1234 for AUD 12.34. It reports [ErrUnknownCurrency] if a's currency
+// isn't known to [Lookup], and an error wrapping [d64.ErrInexact] if a has
+// more decimal places than the minor unit.
+func (a Amount) MinorUnits() (int64, error) {
+	c, ok := Lookup(a.Currency)
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnknownCurrency, a.Currency)
+	}
+	n, err := d64.Context{Rounding: d64.Down}.Unscaled64(a.Value, c.MinorUnits)
+	if err != nil {
+		return 0, fmt.Errorf("money: %v: %w", a, err)
+	}
+	if back, _ := d64.NewFromUnscaled64(n, c.MinorUnits); !back.Equal(a.Value) {
+		return 0, fmt.Errorf("money: %v has more than %d decimal places: %w", a, c.MinorUnits, d64.ErrInexact)
+	}
+	return n, nil
+}
+
+// minorUnit returns the value of one minor unit of a's currency.
+func (a Amount) minorUnit() (d64.Decimal, error) {
+	c, ok := Lookup(a.Currency)
+	if !ok {
+		return d64.QNaN, fmt.Errorf("%w %q", ErrUnknownCurrency, a.Currency)
+	}
+	unit, _ := d64.NewFromUnscaled64(1, c.MinorUnits)
+	return unit, nil
+}
+
+// String returns a as its currency code and value, e.g., "AUD 12.30". The
+// value has as many decimal places as the minor unit of the currency, or more
+// if it hasn't been quantized.
+func (a Amount) String() string {
+	return string(a.appendValue(append([]byte(a.Currency), ' ')))
+}
+
+// appendValue appends a's value to buf with at least as many decimal places
+// as its currency's minor unit.
+func (a Amount) appendValue(buf []byte) []byte {
+	places := -1
+	if c, ok := Lookup(a.Currency); ok {
+		_, _, _, exp := a.Value.Decompose(nil)
+		places = max(c.MinorUnits, int(-exp))
+	}
+	return a.Value.Append(buf, 'f', places)
+}
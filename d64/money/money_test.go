@@ -0,0 +1,154 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/anz-bank/decimal/d64"
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func aud(s string) Amount {
+	return MustNew(d64.MustParse(s), "AUD")
+}
+
+// same checks that a and b have the same currency and equal values, which
+// may be encoded differently.
+func same(t *testing.T, a, b Amount) {
+	t.Helper()
+	if a.Currency != b.Currency || !a.Value.Equal(b.Value) {
+		t.Errorf("expected %v, got %v", a, b)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	a, err := New(d64.MustParse("12.5"), "JPY")
+	expect.Nil(t, err)
+	expect.Equal(t, Amount{d64.MustParse("12.5"), "JPY"}, a)
+
+	_, err = New(d64.One, "ZZZ")
+	expect.ErrorIs(t, err, ErrUnknownCurrency)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	MustNew(d64.One, "ZZZ")
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected Amount, s string) {
+		t.Helper()
+		a, err := Parse(s)
+		expect.Nil(t, err)
+		same(t, expected, a)
+	}
+	test(aud("12.34"), "AUD 12.34")
+	test(aud("12.34"), "12.34 AUD")
+	test(aud("-0.5"), " AUD  -0.5 ")
+	test(MustNew(d64.MustParse("1000"), "JPY"), "JPY 1000")
+
+	for _, s := range []string{"", "12.34", "AUD", "ZZZ 12.34", "AUD 12.3x", "12.34 aud"} {
+		_, err := Parse(s)
+		if err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+	_, err := Parse("AUD 1.2.3")
+	expect.ErrorIs(t, err, d64.ErrSyntax)
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+
+	sum, err := aud("12.34").Add(aud("0.66"))
+	expect.Nil(t, err)
+	same(t, aud("13"), sum)
+
+	diff, err := aud("12.34").Sub(aud("20"))
+	expect.Nil(t, err)
+	same(t, aud("-7.66"), diff)
+
+	same(t, aud("37.02"), aud("12.34").Mul(d64.MustParse("3")))
+	same(t, aud("-12.34"), aud("12.34").Neg())
+	same(t, aud("12.34"), aud("-12.34").Abs())
+	expect.Equal(t, true, aud("0").IsZero())
+
+	c, err := aud("1").Cmp(aud("2"))
+	expect.Nil(t, err)
+	expect.Equal(t, -1, c)
+
+	usd := MustNew(d64.One, "USD")
+	_, err = aud("1").Add(usd)
+	expect.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = aud("1").Sub(usd)
+	expect.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = aud("1").Cmp(usd)
+	expect.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestQuantize(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, a Amount, r d64.Rounding) {
+		t.Helper()
+		q, err := a.Quantize(r)
+		expect.Nil(t, err)
+		expect.Equal(t, expected, q.String())
+	}
+	test("AUD 2.35", aud("2.345"), d64.HalfUp)
+	test("AUD 2.34", aud("2.345"), d64.HalfEven)
+	test("AUD 2.34", aud("2.349"), d64.Down)
+	test("AUD -2.35", aud("-2.345"), d64.HalfUp)
+	test("AUD 0.01", aud("0.005"), d64.HalfUp)
+	test("AUD 0.00", aud("0.004"), d64.HalfUp)
+	test("AUD 1234.00", aud("1234"), d64.HalfUp)
+	test("JPY 1235", MustNew(d64.MustParse("1234.5"), "JPY"), d64.HalfUp)
+	test("BHD 1.235", MustNew(d64.MustParse("1.2345"), "BHD"), d64.HalfUp)
+
+	_, err := Amount{d64.One, "ZZZ"}.Quantize(d64.HalfUp)
+	expect.ErrorIs(t, err, ErrUnknownCurrency)
+}
+
+func TestMinorUnits(t *testing.T) {
+	t.Parallel()
+
+	n, err := aud("12.34").MinorUnits()
+	expect.Nil(t, err)
+	expect.Equal(t, int64(1234), n)
+
+	n, err = MustNew(d64.MustParse("-500"), "JPY").MinorUnits()
+	expect.Nil(t, err)
+	expect.Equal(t, int64(-500), n)
+
+	_, err = aud("12.345").MinorUnits()
+	expect.ErrorIs(t, err, d64.ErrInexact)
+	_, err = aud("1e30").MinorUnits()
+	expect.ErrorIs(t, err, d64.ErrRange)
+	_, err = Amount{d64.One, "ZZZ"}.MinorUnits()
+	expect.ErrorIs(t, err, ErrUnknownCurrency)
+
+	a, err := FromMinorUnits(1234, "AUD")
+	expect.Nil(t, err)
+	same(t, aud("12.34"), a)
+	a, err = FromMinorUnits(-1, "KWD")
+	expect.Nil(t, err)
+	expect.Equal(t, "KWD -0.001", a.String())
+	_, err = FromMinorUnits(1, "ZZZ")
+	expect.ErrorIs(t, err, ErrUnknownCurrency)
+}
+
+func TestAmountString(t *testing.T) {
+	t.Parallel()
+
+	expect.Equal(t, "AUD 12.30", aud("12.3").String())
+	expect.Equal(t, "AUD 12.345", aud("12.345").String())
+	expect.Equal(t, "AUD 1000000.00", aud("1e6").String())
+	expect.Equal(t, "JPY 1000", MustNew(d64.MustParse("1000"), "JPY").String())
+	expect.Equal(t, "AUD NaN", aud("NaN").String())
+	expect.Equal(t, "ZZZ 1.5", Amount{d64.MustParse("1.5"), "ZZZ"}.String())
+}
@@ -0,0 +1,49 @@
+package d64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// MessagePack extension formats that can hold 8 bytes of data.
+const (
+	msgpackFixExt8 = 0xd7
+	msgpackExt8    = 0xc7
+)
+
+// AppendMsgpack appends d to buf as a MessagePack extension of type extType,
+// using the fixext 8 format. The data is the 8-byte big-endian BID encoding
+// returned by [Decimal.MarshalBinary].
+// Applications should use extension types from 0 to 127, since negative types
+// are reserved by the MessagePack specification.
+func (d Decimal) AppendMsgpack(buf []byte, extType int8) []byte {
+	buf = append(buf, msgpackFixExt8, byte(extType))
+	return binary.BigEndian.AppendUint64(buf, d.bits)
+}
+
+// DecodeMsgpack decodes the MessagePack extension of type extType at the
+// start of b into d and returns its length in bytes.
+// It accepts the fixext 8 format written by [Decimal.AppendMsgpack] and the
+// equivalent ext 8 format with a length of 8. On error, d is left unchanged
+// and the length is zero.
+func (d *Decimal) DecodeMsgpack(b []byte, extType int8) (int, error) {
+	var typ byte
+	var n int
+	switch {
+	case len(b) >= 10 && b[0] == msgpackFixExt8:
+		typ, n = b[1], 10
+	case len(b) >= 11 && b[0] == msgpackExt8 && b[1] == 8:
+		typ, n = b[2], 11
+	case len(b) > 0 && (b[0] == msgpackFixExt8 || b[0] == msgpackExt8):
+		return 0, fmt.Errorf("msgpack: decimal extension must hold 8 bytes")
+	case len(b) > 0:
+		return 0, fmt.Errorf("msgpack: expected decimal extension, found format 0x%02x", b[0])
+	default:
+		return 0, fmt.Errorf("msgpack: unexpected end of input")
+	}
+	if int8(typ) != extType {
+		return 0, fmt.Errorf("msgpack: extension type %d, want %d", int8(typ), extType)
+	}
+	*d = newDec(binary.BigEndian.Uint64(b[n-8 : n]))
+	return n, nil
+}
@@ -0,0 +1,49 @@
+package d64
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestDecimalMsgpack(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, d Decimal) {
+		t.Helper()
+		buf := d.AppendMsgpack(nil, 1)
+		equal(t, expected, hex.EncodeToString(buf))
+
+		var e Decimal
+		n, err := e.DecodeMsgpack(append(buf, 0xc0), 1)
+		isnil(t, err)
+		equal(t, 10, n)
+		equal(t, d.bits, e.bits)
+	}
+
+	test("d7012fe38d7ea4c68000", One)
+	test("d7017c00000000000000", QNaN)
+	test("d701f800000000000000", NegInf)
+
+	var d Decimal
+	n, err := d.DecodeMsgpack([]byte{0xc7, 8, 1, 0x2f, 0xe3, 0x8d, 0x7e, 0xa4, 0xc6, 0x80, 0x00}, 1)
+	isnil(t, err)
+	equal(t, 11, n)
+	equalD64(t, One, d)
+
+	invalid := func(s string, extType int8) {
+		t.Helper()
+		b, err := hex.DecodeString(s)
+		isnil(t, err)
+		d := Pi
+		n, err := d.DecodeMsgpack(b, extType)
+		notnil(t, err)
+		equal(t, 0, n)
+		equalD64(t, Pi, d)
+	}
+
+	invalid("", 1)
+	invalid("c0", 1)
+	invalid("d7012fe38d7ea4c68000", 2)
+	invalid("d7012fe38d7ea4c680", 1)
+	invalid("c704012fe38d7e", 1)
+}
@@ -0,0 +1,94 @@
+package d64
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+)
+
+var _ sql.Scanner = (*NullDecimal)(nil)
+var _ driver.Valuer = NullDecimal{}
+var _ json.Marshaler = NullDecimal{}
+var _ json.Unmarshaler = (*NullDecimal)(nil)
+var _ encoding.TextMarshaler = NullDecimal{}
+var _ encoding.TextUnmarshaler = (*NullDecimal)(nil)
+
+// NullDecimal represents a [Decimal] that may be null.
+// It behaves like [sql.NullFloat64] and also maps null to and from JSON null
+// and empty text. Scan, UnmarshalJSON and UnmarshalText leave it null if they
+// fail.
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool // Valid is true if Decimal is not NULL
+}
+
+// Scan implements [sql.Scanner].
+// Non-null values are scanned with [DefaultSQLOptions].
+func (n *NullDecimal) Scan(src any) error {
+	if src == nil {
+		n.Decimal, n.Valid = Zero, false
+		return nil
+	}
+	if err := DefaultSQLOptions.Scan(&n.Decimal, src); err != nil {
+		n.Decimal, n.Valid = Zero, false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements [driver.Valuer].
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Decimal.Value()
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return bytes.Clone(jsonNull), nil
+	}
+	return n.Decimal.MarshalJSON()
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (n *NullDecimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		n.Decimal, n.Valid = Zero, false
+		return nil
+	}
+	if err := n.Decimal.UnmarshalJSON(data); err != nil {
+		n.Decimal, n.Valid = Zero, false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+// A null value is marshalled as empty text.
+func (n NullDecimal) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Decimal.MarshalText()
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+// Empty text is unmarshalled as null.
+func (n *NullDecimal) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Decimal, n.Valid = Zero, false
+		return nil
+	}
+	if err := n.Decimal.UnmarshalText(text); err != nil {
+		n.Decimal, n.Valid = Zero, false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,85 @@
+package d64
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullDecimalSQL(t *testing.T) {
+	t.Parallel()
+
+	var n NullDecimal
+	isnil(t, n.Scan("12.5"))
+	equal(t, true, n.Valid)
+	equalD64(t, MustParse("12.5"), n.Decimal)
+
+	v, err := n.Value()
+	isnil(t, err)
+	equal(t, any("12.5"), v)
+
+	isnil(t, n.Scan(nil))
+	equal(t, false, n.Valid)
+
+	v, err = n.Value()
+	isnil(t, err)
+	isnil(t, v)
+
+	isnil(t, n.Scan("12.5"))
+	notnil(t, n.Scan("omg"))
+	equal(t, false, n.Valid)
+	equal(t, Zero, n.Decimal)
+}
+
+func TestNullDecimalJSON(t *testing.T) {
+	t.Parallel()
+
+	type fees struct {
+		Fee   NullDecimal `json:"fee"`
+		Limit NullDecimal `json:"limit"`
+	}
+
+	j, err := json.Marshal(fees{Fee: NullDecimal{MustParse("1.25"), true}})
+	isnil(t, err)
+	equal(t, `{"fee":1.25,"limit":null}`, string(j))
+
+	j, err = NullDecimal{}.MarshalJSON()
+	isnil(t, err)
+	equal(t, "null", string(j))
+
+	var f fees
+	isnil(t, json.Unmarshal([]byte(`{"fee":null,"limit":100}`), &f))
+	equal(t, false, f.Fee.Valid)
+	equal(t, true, f.Limit.Valid)
+	equalD64(t, NewFromInt64(100), f.Limit.Decimal)
+
+	notnil(t, json.Unmarshal([]byte(`{"fee":"omg"}`), &f))
+	equal(t, false, f.Fee.Valid)
+
+	n := NullDecimal{MustParse("1.25"), true}
+	notnil(t, n.UnmarshalJSON([]byte(`"omg"`)))
+	equal(t, false, n.Valid)
+	equal(t, Zero, n.Decimal)
+}
+
+func TestNullDecimalText(t *testing.T) {
+	t.Parallel()
+
+	text, err := NullDecimal{}.MarshalText()
+	isnil(t, err)
+	equal(t, "", string(text))
+
+	text, err = NullDecimal{NewFromInt64(-7), true}.MarshalText()
+	isnil(t, err)
+	equal(t, "-7", string(text))
+
+	var n NullDecimal
+	isnil(t, n.UnmarshalText([]byte("3.5")))
+	equal(t, true, n.Valid)
+	isnil(t, n.UnmarshalText(nil))
+	equal(t, false, n.Valid)
+
+	isnil(t, n.UnmarshalText([]byte("3.5")))
+	notnil(t, n.UnmarshalText([]byte("omg")))
+	equal(t, false, n.Valid)
+	equal(t, Zero, n.Decimal)
+}
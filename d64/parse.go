@@ -0,0 +1,133 @@
+package d64
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Parse parses a string representation of a number as a [Decimal].
+// It uses [DefaultScanContext].
+func Parse(s string) (Decimal, error) {
+	return DefaultScanContext.Parse(s)
+}
+
+// ParseBytes parses a byte slice representation of a number as a [Decimal].
+// It uses [DefaultScanContext].
+func ParseBytes(b []byte) (Decimal, error) {
+	return DefaultScanContext.ParseBytes(b)
+}
+
+// Parse parses a string representation of a number as a [Decimal].
+// It accepts the same syntax as [Context.Scan] and doesn't allocate unless
+// it reports an error, which is always a [*ParseError].
+func (ctx Context) Parse(s string) (Decimal, error) {
+	return parseText(ctx, ParseOptions{}, s, "Parse")
+}
+
+// ParseBytes parses a byte slice representation of a number as a [Decimal].
+// It accepts the same syntax as [Context.Scan] and doesn't allocate unless
+// it reports an error, which is always a [*ParseError].
+func (ctx Context) ParseBytes(b []byte) (Decimal, error) {
+	return parseText(ctx, ParseOptions{}, b, "ParseBytes")
+}
+
+// ParseOptions restricts the input accepted by [ParseOptions.Parse] and
+// [ParseOptions.ParseBytes]. The zero value accepts everything [Parse] does.
+//
+// For example, ParseOptions{Exact: true, NoOverflow: true, NoUnderflow: true}
+// guarantees that a parsed number has exactly the value written.
+type ParseOptions struct {
+	// Exact rejects numbers that can't be represented without rounding,
+	// reporting [ErrInexact], instead of rounding them.
+	Exact bool
+
+	// NoOverflow rejects numbers too large for a [Decimal], reporting
+	// [ErrRange], instead of returning ±∞.
+	NoOverflow bool
+
+	// NoUnderflow rejects nonzero numbers too small for a [Decimal],
+	// reporting [ErrRange], instead of returning ±0.
+	NoUnderflow bool
+
+	// NoSpecials rejects infinities and NaNs.
+	NoSpecials bool
+
+	// NoSign rejects a leading '+' or '-'.
+	NoSign bool
+
+	// NoExponent rejects exponent notation, such as "1e3".
+	NoExponent bool
+}
+
+// Parse parses a string representation of a number as a [Decimal], subject
+// to the restrictions in o. It uses [DefaultScanContext] to round.
+// It doesn't allocate unless it reports an error, which is always a
+// [*ParseError].
+func (o ParseOptions) Parse(s string) (Decimal, error) {
+	return parseText(DefaultScanContext, o, s, "Parse")
+}
+
+// ParseBytes parses a byte slice representation of a number as a [Decimal],
+// subject to the restrictions in o. It uses [DefaultScanContext] to round.
+// It doesn't allocate unless it reports an error, which is always a
+// [*ParseError].
+func (o ParseOptions) ParseBytes(b []byte) (Decimal, error) {
+	return parseText(DefaultScanContext, o, b, "ParseBytes")
+}
+
+// ParseError records a failure to parse a [Decimal]. Like
+// [strconv.NumError], it works with [errors.Is]: Err is [ErrSyntax],
+// [ErrRange] or [ErrInexact].
+type ParseError struct {
+	Func   string // The failing function, such as "Parse"
+	Input  string // The input
+	Offset int    // Byte offset in Input where the problem was found
+	Reason string // Description of the problem
+	Err    error  // ErrSyntax, ErrRange or ErrInexact
+}
+
+func (e *ParseError) Error() string {
+	return "d64." + e.Func + ": parsing " + strconv.Quote(e.Input) + ": " + e.Reason
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseText parses all of s for the function fn.
+func parseText[T string | []byte](ctx Context, o ParseOptions, s T, fn string) (Decimal, error) {
+	var p numParser
+	for p.n < len(s) && p.feed(s[p.n]) {
+	}
+	fail := func(offset int, reason string, err error) (Decimal, error) {
+		return QNaN, &ParseError{Func: fn, Input: string(s), Offset: offset, Reason: reason, Err: err}
+	}
+
+	if reason := p.syntaxError(); reason != "" {
+		return fail(p.n, reason, ErrSyntax)
+	}
+	if p.n < len(s) {
+		r, _ := utf8.DecodeRune([]byte(s[p.n:]))
+		return fail(p.n, fmt.Sprintf("unexpected %q", r), ErrSyntax)
+	}
+	switch {
+	case o.NoSign && p.signed:
+		return fail(0, "sign not allowed", ErrSyntax)
+	case o.NoSpecials && p.special():
+		return fail(p.kwAt, "infinity and NaN not allowed", ErrSyntax)
+	case o.NoExponent && p.state == psExp:
+		return fail(p.expAt, "exponent not allowed", ErrSyntax)
+	}
+
+	d, err := p.value(ctx)
+	switch {
+	case err == ErrRange && o.NoOverflow:
+		return fail(0, "value too large", ErrRange)
+	case p.underflow(d) && o.NoUnderflow:
+		return fail(0, "value too small", ErrRange)
+	case err == ErrInexact && o.Exact:
+		return fail(0, "value not exactly representable", ErrInexact)
+	}
+	return d, nil
+}
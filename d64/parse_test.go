@@ -0,0 +1,157 @@
+package d64
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"unsafe"
+)
+
+func TestParseBytes(t *testing.T) {
+	t.Parallel()
+
+	d, err := ParseBytes([]byte("-12.5e-1"))
+	isnil(t, err)
+	equalD64(t, MustParse("-1.25"), d)
+
+	_, err = ParseBytes([]byte("1.5x"))
+	equal(t, `d64.ParseBytes: parsing "1.5x": unexpected 'x'`, err.Error())
+
+	_, err = ParseBytes([]byte("1.5∞"))
+	equal(t, `d64.ParseBytes: parsing "1.5∞": unexpected '∞'`, err.Error())
+
+	_, err = ParseBytes(nil)
+	notnil(t, err)
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	test := func(input string, offset int, reason string) {
+		t.Helper()
+		_, err := Parse(input)
+		var pe *ParseError
+		if check(t, errors.As(err, &pe)) {
+			equal(t, "Parse", pe.Func)
+			equal(t, input, pe.Input)
+			equal(t, offset, pe.Offset)
+			equal(t, reason, pe.Reason)
+			check(t, errors.Is(err, ErrSyntax))
+		}
+	}
+
+	test("", 0, "mantissa missing")
+	test("-.", 2, "mantissa missing")
+	test("1e", 2, "exponent value missing")
+	test("1e+", 3, "exponent value missing")
+	test("infin", 5, "not a valid Decimal")
+	test("NaN1000000000000000", 19, "NaN payload too large")
+	test("1..", 2, "unexpected '.'")
+	test("12 ", 2, "unexpected ' '")
+
+	_, err := DefaultContext.Parse("x")
+	equal(t, `d64.Parse: parsing "x": mantissa missing`, err.Error())
+
+	var d Decimal
+	err = d.UnmarshalText([]byte("1y"))
+	equal(t, `d64.UnmarshalText: parsing "1y": unexpected 'y'`, err.Error())
+
+	_, err = fmt.Sscan("-e", &d)
+	var pe *ParseError
+	if check(t, errors.As(err, &pe)) {
+		equal(t, "Scan", pe.Func)
+		equal(t, "-", pe.Input)
+	}
+}
+
+func TestParseOptions(t *testing.T) {
+	t.Parallel()
+
+	valid := func(o ParseOptions, expected Decimal, input string) {
+		t.Helper()
+		d, err := o.Parse(input)
+		isnil(t, err)
+		equalD64(t, expected, d)
+		d, err = o.ParseBytes([]byte(input))
+		isnil(t, err)
+		equalD64(t, expected, d)
+	}
+	invalid := func(o ParseOptions, target error, offset int, input string) {
+		t.Helper()
+		_, err := o.Parse(input)
+		check(t, errors.Is(err, target)).Or(func() { t.Errorf("%v", err) })
+		var pe *ParseError
+		if check(t, errors.As(err, &pe)) {
+			equal(t, offset, pe.Offset)
+		}
+	}
+
+	var lenient ParseOptions
+	valid(lenient, MustParse("1.234567890123457"), "1.2345678901234567")
+	valid(lenient, Inf, "1e385")
+	valid(lenient, Zero, "1e-500")
+	valid(lenient, NegInf, "-∞")
+
+	exact := ParseOptions{Exact: true}
+	valid(exact, MustParse("1.234567890123456"), "1.2345678901234560000")
+	valid(exact, Min, "1e-398")
+	valid(exact, Inf, "1e385")
+	invalid(exact, ErrInexact, 0, "1.2345678901234567")
+	invalid(exact, ErrInexact, 0, "1.5e-398")
+	invalid(exact, ErrInexact, 0, "1e-500")
+
+	valid(ParseOptions{NoOverflow: true}, Max, "9999999999999999e369")
+	invalid(ParseOptions{NoOverflow: true}, ErrRange, 0, "1e385")
+	invalid(ParseOptions{NoOverflow: true}, ErrRange, 0, "-9.9999999999999999e384")
+
+	valid(ParseOptions{NoUnderflow: true}, Zero, "0e-500")
+	valid(ParseOptions{NoUnderflow: true}, MustParse("2e-398"), "1.5e-398")
+	invalid(ParseOptions{NoUnderflow: true}, ErrRange, 0, "1e-500")
+	invalid(ParseOptions{NoUnderflow: true, Exact: true}, ErrRange, 0, "-1e-500")
+
+	noSpecials := ParseOptions{NoSpecials: true}
+	valid(noSpecials, One, "1")
+	invalid(noSpecials, ErrSyntax, 1, "-inf")
+	invalid(noSpecials, ErrSyntax, 0, "NaN")
+	invalid(noSpecials, ErrSyntax, 0, "∞")
+
+	noSign := ParseOptions{NoSign: true}
+	valid(noSign, MustParse("1e-3"), "1e-3")
+	invalid(noSign, ErrSyntax, 0, "+1")
+	invalid(noSign, ErrSyntax, 0, "-1")
+
+	noExponent := ParseOptions{NoExponent: true}
+	valid(noExponent, MustParse("0.001"), ".001")
+	invalid(noExponent, ErrSyntax, 4, "12.5e3")
+	invalid(noExponent, ErrSyntax, 1, "1E0")
+}
+
+func TestParseBytesAllocs(t *testing.T) {
+	if unsafe.Sizeof(Zero) != unsafe.Sizeof(uint64(0)) {
+		t.Skip("decimal_debug builds allocate debug strings")
+	}
+	b := []byte("-1234567.890123456789e-3")
+	equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		_, _ = ParseBytes(b)
+	}))
+	equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		_, _ = Parse("NaN123")
+	}))
+}
+
+func BenchmarkParse(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		if _, err := Parse("123456.789"); err != nil {
+			panic("Benchmarking Parse failed")
+		}
+	}
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	text := []byte("123456.789")
+	for n := 0; n < b.N; n++ {
+		if _, err := ParseBytes(text); err != nil {
+			panic("Benchmarking ParseBytes failed")
+		}
+	}
+}
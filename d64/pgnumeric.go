@@ -0,0 +1,171 @@
+package d64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Sign field values of the Postgres NUMERIC binary format.
+const (
+	pgNumericPos  = 0x0000
+	pgNumericNeg  = 0x4000
+	pgNumericNaN  = 0xc000
+	pgNumericPInf = 0xd000
+	pgNumericNInf = 0xf000
+
+	pgNumericMaxScale = 0x3fff
+	pgNumericNBase    = 10000
+)
+
+// AppendPGNumeric appends the Postgres NUMERIC binary wire format of d to buf.
+//
+// The format is a header of four 16-bit big-endian fields (ndigits, weight,
+// sign and dscale) followed by ndigits base-10000 digit groups.
+// The dscale field is the number of digits after the decimal point that
+// Postgres displays. If dscale is negative, the natural scale of d is used,
+// which is the number of digits after the decimal point once trailing zeros
+// are removed. AppendPGNumeric reports an error wrapping [ErrInexact] if d has
+// more fractional digits than dscale, rather than rounding.
+//
+// NaN and ±∞ map to the Postgres NaN and ±Infinity values. Postgres has no
+// negative zero, so -0 is encoded as 0.
+func (d Decimal) AppendPGNumeric(buf []byte, dscale int) ([]byte, error) {
+	var dp decParts
+	dp.unpack(d)
+	switch {
+	case dp.fl == flInf:
+		if dp.sign == 1 {
+			return appendPGNumericHeader(buf, 0, 0, pgNumericNInf, 0), nil
+		}
+		return appendPGNumericHeader(buf, 0, 0, pgNumericPInf, 0), nil
+	case dp.fl.nan():
+		return appendPGNumericHeader(buf, 0, 0, pgNumericNaN, 0), nil
+	}
+
+	dp.trimZeros()
+	exp := int(dp.exp)
+	scale := max(0, -exp)
+	switch {
+	case dscale < 0:
+		dscale = scale
+	case dscale < scale:
+		return buf, fmt.Errorf("numeric dscale %d too small for %v: %w", dscale, d, ErrInexact)
+	case dscale > pgNumericMaxScale:
+		return buf, fmt.Errorf("numeric dscale %d out of range", dscale)
+	}
+	if dp.significand.lo == 0 {
+		return appendPGNumericHeader(buf, 0, 0, pgNumericPos, dscale), nil
+	}
+
+	// Align the exponent down to a multiple of four so that each group of
+	// four digits is a base-10000 digit. At most three digits are added to
+	// the 16-digit significand, so the result still fits in a uint64.
+	e4 := exp - (exp%4+4)%4
+	c := dp.significand.lo * tenToThe[exp-e4]
+	for c%pgNumericNBase == 0 {
+		c /= pgNumericNBase
+		e4 += 4
+	}
+	var groups [5]uint16
+	n := 0
+	for ; c > 0; c /= pgNumericNBase {
+		groups[n] = uint16(c % pgNumericNBase)
+		n++
+	}
+
+	sign := pgNumericPos
+	if dp.sign == 1 {
+		sign = pgNumericNeg
+	}
+	buf = appendPGNumericHeader(buf, n, e4/4+n-1, sign, dscale)
+	for i := n - 1; i >= 0; i-- {
+		buf = binary.BigEndian.AppendUint16(buf, groups[i])
+	}
+	return buf, nil
+}
+
+func appendPGNumericHeader(buf []byte, ndigits, weight, sign, dscale int) []byte {
+	buf = binary.BigEndian.AppendUint16(buf, uint16(ndigits))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(int16(weight)))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(sign))
+	return binary.BigEndian.AppendUint16(buf, uint16(dscale))
+}
+
+// DecodePGNumeric decodes a value in the Postgres NUMERIC binary wire format,
+// as described in [Decimal.AppendPGNumeric].
+// It also returns the dscale field so that callers can preserve the display
+// scale when writing the value back.
+//
+// DecodePGNumeric never rounds. It reports an error wrapping [ErrInexact] if
+// the value has more than 16 significant digits or is too close to zero,
+// and an error wrapping [ErrRange] if the value is too large for a [Decimal].
+func DecodePGNumeric(b []byte) (d Decimal, dscale int, err error) {
+	if len(b) < 8 {
+		return QNaN, 0, fmt.Errorf("numeric too short: %d bytes", len(b))
+	}
+	ndigits := int(int16(binary.BigEndian.Uint16(b)))
+	weight := int(int16(binary.BigEndian.Uint16(b[2:])))
+	sign := binary.BigEndian.Uint16(b[4:])
+	dscale = int(binary.BigEndian.Uint16(b[6:]))
+	if ndigits < 0 || len(b) != 8+2*ndigits {
+		return QNaN, 0, fmt.Errorf("numeric has %d bytes for %d digits", len(b), ndigits)
+	}
+
+	var s int8
+	switch sign {
+	case pgNumericPos:
+	case pgNumericNeg:
+		s = 1
+	case pgNumericNaN:
+		return QNaN, 0, nil
+	case pgNumericPInf:
+		return Inf, 0, nil
+	case pgNumericNInf:
+		return NegInf, 0, nil
+	default:
+		return QNaN, 0, fmt.Errorf("invalid numeric sign 0x%04x", sign)
+	}
+	if dscale > pgNumericMaxScale {
+		return QNaN, 0, fmt.Errorf("numeric dscale %d out of range", dscale)
+	}
+
+	digits := b[8:]
+	for len(digits) > 0 && binary.BigEndian.Uint16(digits) == 0 {
+		digits = digits[2:]
+		weight--
+	}
+	for len(digits) > 0 && binary.BigEndian.Uint16(digits[len(digits)-2:]) == 0 {
+		digits = digits[:len(digits)-2]
+	}
+	if len(digits) == 0 {
+		return Zero, dscale, nil
+	}
+
+	// Nine groups hold at most 36 digits, which fit in 128 bits. Any more
+	// groups have more than 16 significant digits between them.
+	n := len(digits) / 2
+	if n > 9 {
+		return QNaN, 0, fmt.Errorf("numeric with %d digit groups: %w", n, ErrInexact)
+	}
+	var c uint128T
+	for i := 0; i < n; i++ {
+		g := binary.BigEndian.Uint16(digits[2*i:])
+		if g >= pgNumericNBase {
+			return QNaN, 0, fmt.Errorf("invalid numeric digit %d", g)
+		}
+		c.mul64(&c, pgNumericNBase)
+		c.add(&c, &uint128T{lo: uint64(g)})
+	}
+
+	d, err = DefaultContext.newFromCoefficient(s, 4*(weight-n+1), c, false)
+	if err != nil {
+		return QNaN, 0, fmt.Errorf("numeric: %w", err)
+	}
+	var dp decParts
+	dp.unpack(d)
+	dp.trimZeros()
+	if -int(dp.exp) > dscale {
+		return QNaN, 0, fmt.Errorf("numeric has nonzero digits beyond dscale %d", dscale)
+	}
+	return d, dscale, nil
+}
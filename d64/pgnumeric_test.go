@@ -0,0 +1,87 @@
+package d64
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func pgNumeric(weight, sign, dscale int, digits ...uint16) []byte {
+	buf := appendPGNumericHeader(nil, len(digits), weight, sign, dscale)
+	for _, g := range digits {
+		buf = binary.BigEndian.AppendUint16(buf, g)
+	}
+	return buf
+}
+
+func TestDecimalAppendPGNumeric(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected []byte, d Decimal, dscale int) {
+		t.Helper()
+		buf, err := d.AppendPGNumeric(nil, dscale)
+		isnil(t, err)
+		equal(t, fmt.Sprintf("%x", expected), fmt.Sprintf("%x", buf))
+
+		e, scale, err := DecodePGNumeric(buf)
+		isnil(t, err)
+		equal(t, d.Equal(e) || d.IsNaN() && e.IsNaN(), true)
+		equal(t, int(binary.BigEndian.Uint16(expected[6:])), scale)
+	}
+
+	test(pgNumeric(0, pgNumericPos, 2, 12, 5000), MustParse("12.50"), 2)
+	test(pgNumeric(0, pgNumericPos, 1, 12, 5000), MustParse("12.50"), -1)
+	test(pgNumeric(0, pgNumericNeg, 1, 1, 5000), MustParse("-1.5"), -1)
+	test(pgNumeric(-1, pgNumericPos, 4, 1), MustParse("0.0001"), -1)
+	test(pgNumeric(1, pgNumericPos, 0, 1), NewFromInt64(10000), -1)
+	test(pgNumeric(2, pgNumericPos, 0, 1, 0, 1), NewFromInt64(100000001), -1)
+	test(pgNumeric(3, pgNumericPos, 0, 1234, 5678, 9012, 3456), MustParse("1234567890123456"), -1)
+	test(pgNumeric(75, pgNumericPos, 0, 1), MustParse("1e300"), -1)
+	test(pgNumeric(-100, pgNumericPos, 398, 100), Min, -1)
+	test(pgNumeric(0, pgNumericPos, 0), Zero, -1)
+	test(pgNumeric(0, pgNumericPos, 3), NegZero, 3)
+	test(pgNumeric(0, pgNumericNaN, 0), QNaN, -1)
+	test(pgNumeric(0, pgNumericPInf, 0), Inf, 2)
+	test(pgNumeric(0, pgNumericNInf, 0), NegInf, -1)
+
+	_, err := MustParse("1.25").AppendPGNumeric(nil, 1)
+	check(t, errors.Is(err, ErrInexact))
+	_, err = One.AppendPGNumeric(nil, pgNumericMaxScale+1)
+	notnil(t, err)
+}
+
+func TestDecodePGNumeric(t *testing.T) {
+	t.Parallel()
+
+	valid := func(expected string, b []byte) {
+		t.Helper()
+		d, _, err := DecodePGNumeric(b)
+		isnil(t, err)
+		equalD64(t, MustParse(expected), d)
+	}
+	invalid := func(target error, b []byte) {
+		t.Helper()
+		_, _, err := DecodePGNumeric(b)
+		notnil(t, err)
+		if target != nil {
+			check(t, errors.Is(err, target))
+		}
+	}
+
+	valid("12.5", pgNumeric(1, pgNumericPos, 2, 0, 12, 5000, 0, 0))
+	valid("1e20", pgNumeric(5, pgNumericPos, 0, 1))
+	valid("9.999999999999999e384", pgNumeric(96, pgNumericPos, 0, 9, 9999, 9999, 9999, 9990))
+	valid("0", pgNumeric(2, pgNumericNeg, 0, 0, 0))
+
+	invalid(nil, nil)
+	invalid(nil, pgNumeric(0, pgNumericPos, 0, 1)[:9])
+	invalid(nil, pgNumeric(0, 0x8000, 0, 1))
+	invalid(nil, pgNumeric(0, pgNumericPos, 0x4000, 1))
+	invalid(nil, pgNumeric(0, pgNumericPos, 0, 10000))
+	invalid(nil, pgNumeric(-1, pgNumericPos, 3, 1))
+	invalid(ErrInexact, pgNumeric(4, pgNumericPos, 0, 1, 2, 3, 4, 5))
+	invalid(ErrInexact, pgNumeric(9, pgNumericPos, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 1))
+	invalid(ErrInexact, pgNumeric(-101, pgNumericPos, 1000, 1))
+	invalid(ErrRange, pgNumeric(97, pgNumericPos, 0, 1))
+}
@@ -3,33 +3,11 @@ package d64
 import (
 	"fmt"
 	"io"
-	"strings"
+	"unicode/utf8"
 )
 
 var DefaultScanContext = DefaultFormatContext
 
-// Parse parses a string representation of a number as a [Decimal].
-// It uses [DefaultScanContext].
-func Parse(s string) (Decimal, error) {
-	return DefaultScanContext.Parse(s)
-}
-
-// Parse parses a string representation of a number as a [Decimal].
-func (ctx Context) Parse(s string) (Decimal, error) {
-	state := &scanner{reader: strings.NewReader(s)}
-	var d Decimal
-	if err := ctx.Scan(&d, state, 'e'); err != nil {
-		return d, err
-	}
-
-	// entire string must have been consumed
-	r, _, err := state.ReadRune()
-	if err == nil {
-		return QNaN, fmt.Errorf("expected end of string, found %c", r)
-	}
-	return d, nil
-}
-
 // MustParse parses a string as a [Decimal] and returns the value or
 // panics if the string doesn't represent a valid [Decimal].
 // It uses [DefaultScanContext].
@@ -54,243 +32,310 @@ func (d *Decimal) Scan(state fmt.ScanState, verb rune) error {
 }
 
 // Scan scans a string into a [Decimal], applying context rounding.
+//
+// It accepts an optional sign followed by decimal digits with an optional
+// decimal point and exponent, as in "-12.5e3", or by one of "inf", "Inf",
+// "infinity", "Infinity" or "∞", or by one of "nan", "NaN", "qnan", "qNaN",
+// "snan" or "sNaN" with an optional decimal payload. Numbers with more than
+// 16 significant digits are rounded, those too large for a [Decimal] become
+// ±∞ and those too small become ±0.
+//
+// Syntax errors are reported as a [*ParseError] whose input is the text read
+// so far.
 func (ctx Context) Scan(d *Decimal, state fmt.ScanState, verb rune) error {
 	*d = SNaN
-	sign, err := eatRune(state, '+', '-')
-	if err != nil {
-		return err
-	}
-	if sign < 0 {
-		sign = 0
-	}
-	// Word-number: [Ii]nf(inity)?|∞|[qs]?(nan|NaN)
-	kw, err := keywords.Match(state)
-	if err != nil {
-		return err
-	}
-	switch kw {
-	case 0:
-	case 1:
-		if sign == 0 {
-			*d = Inf
-		} else {
-			*d = NegInf
+	var p numParser
+	var buf [32]byte
+	text := buf[:0]
+	for {
+		r, _, err := state.ReadRune()
+		if err == io.EOF {
+			break
 		}
-		return nil
-	case 3:
-		payload, _ := eatBytes(state, isDigit)
-		payloadInt, _, err := ctx.parseUint(payload)
 		if err != nil {
 			return err
 		}
-		*d = newPayloadNan(sign, flQNaN, uint64(payloadInt))
-		return nil
-	case 2:
-		payload, _ := eatBytes(state, isDigit)
-		payloadInt, _, err := ctx.parseUint(payload)
-		if err != nil {
-			return err
+		if !p.feedRune(r) {
+			if err := state.UnreadRune(); err != nil {
+				return err
+			}
+			break
 		}
-		*d = newPayloadNan(sign, flSNaN, uint64(payloadInt))
-		return nil
-	default:
-		return errNotDecimal
+		text = utf8.AppendRune(text, r)
 	}
-
-	whole, err := eatBytes(state, isDigit)
-	if err != nil {
-		return err
+	if reason := p.syntaxError(); reason != "" {
+		return &ParseError{Func: "Scan", Input: string(text), Offset: p.n, Reason: reason, Err: ErrSyntax}
 	}
-	var buf [64]byte
-	mantissa := append(buf[:0], whole...)
+	*d, _ = p.value(ctx)
+	return nil
+}
 
-	if _, err := eatRune(state, '.', -1); err != nil {
-		return err
-	}
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
 
-	frac, err := eatBytes(state, isDigit)
-	if err != nil {
-		return err
-	}
+var errNotDecimal error = Error("not a valid Decimal")
 
-	mantissa = append(mantissa, frac...)
-	if len(mantissa) == 0 {
-		return fmt.Errorf("mantissa missing")
-	}
+// parseState is a state of a [numParser].
+type parseState uint8
 
-	e, err := eatRune(state, 'e', 'E')
-	if err != nil {
-		return err
-	}
+const (
+	psStart    parseState = iota // Nothing read
+	psSign                       // Sign read
+	psPoint                      // Decimal point read, but no digits
+	psInt                        // Integer digits read
+	psFrac                       // Decimal point read after or before digits
+	psExpStart                   // Exponent marker read
+	psExpSign                    // Exponent sign read
+	psExp                        // Exponent digits read
+	psKeyword                    // Part of a keyword read
+	psPayload                    // NaN payload digits read
+)
 
-	var expSign int
-	var exp []byte
-	if e != -1 {
-		expSign, err = eatRune(state, '+', '-')
-		if err != nil {
-			return err
-		}
-		if expSign < 0 {
-			expSign = 0
-		}
-		exp, err = eatBytes(state, isDigit)
-		if err != nil {
-			return err
-		}
-		if len(exp) == 0 {
-			return fmt.Errorf("exponent value missing")
-		}
-	}
+// keywords are the words numParser accepts in place of digits.
+var keywords = [...]string{
+	"inf", "Inf", "infinity", "Infinity", "∞",
+	"nan", "NaN", "qnan", "qNaN", "snan", "sNaN",
+}
 
-	significand, sExp, err := ctx.parseUint(mantissa)
-	if err != nil {
-		return err
-	}
-	if significand == 0 {
-		*d = zeroes[sign]
-		return nil
-	}
+// maxKeywordLen is the length in bytes of the longest keyword.
+const maxKeywordLen = 8
 
-	uexponent, _, err := ctx.parseUint(exp)
-	if err != nil {
-		return err
-	}
-	exponent := int64(uexponent)
-	exponent *= int64(1 - 2*expSign)
-	if exponent > 1000 {
-		*d = infinities[sign]
-		return nil
-	} else if exponent < -1000 {
-		*d = zeroes[sign]
-		return nil
-	}
-	exponent += int64(sExp - len(frac))
+// parseMaxExp bounds the exponent numParser accumulates, well beyond the
+// point where every number overflows or underflows.
+const parseMaxExp = 1 << 20
 
-	partExp, partSignificand := renormalize(int16(exponent), uint64(significand))
-	*d = newFromParts(int8(sign), partExp, partSignificand)
-	return nil
+// numParser is a state machine that recognizes the syntax accepted by
+// [Context.Scan] one byte at a time. Its zero value is ready to use.
+type numParser struct {
+	state   parseState
+	sign    int8
+	expSign int8
+	signed  bool // A sign was read
+	n       int  // Bytes read
+	digits  int  // Significant digits in coef
+	coef    uint64
+	exp     int
+	expVal  int
+	expAt   int  // Offset of the exponent marker
+	sticky  bool // Nonzero digits were discarded from coef
+	kw      [maxKeywordLen]byte
+	kwLen   int
+	kwAt    int // Offset of the keyword
 }
 
-func isDigit(r rune) bool {
-	return '0' <= r && r <= '9'
+// feed advances p past c and reports whether c continues the number. If it
+// doesn't, p is unchanged.
+func (p *numParser) feed(c byte) bool {
+	if !p.step(c) {
+		return false
+	}
+	p.n++
+	return true
 }
 
-var errNotDecimal error = Error("not a valid Decimal")
-
-func allZeros(s []byte) bool {
-	for _, c := range s {
-		if c != '0' {
+func (p *numParser) step(c byte) bool {
+	switch p.state {
+	case psStart:
+		if c == '+' || c == '-' {
+			p.sign = int8(c-'+') / 2
+			p.signed = true
+			p.state = psSign
+			return true
+		}
+		fallthrough
+	case psSign:
+		switch {
+		case '0' <= c && c <= '9':
+			p.state = psInt
+			p.intDigit(c - '0')
+		case c == '.':
+			p.state = psPoint
+		default:
+			return p.keyword(c)
+		}
+	case psInt:
+		switch {
+		case '0' <= c && c <= '9':
+			p.intDigit(c - '0')
+		case c == '.':
+			p.state = psFrac
+		case c == 'e' || c == 'E':
+			p.state = psExpStart
+			p.expAt = p.n
+		default:
+			return false
+		}
+	case psPoint, psFrac:
+		switch {
+		case '0' <= c && c <= '9':
+			p.state = psFrac
+			p.fracDigit(c - '0')
+		case (c == 'e' || c == 'E') && p.state == psFrac:
+			p.state = psExpStart
+			p.expAt = p.n
+		default:
 			return false
 		}
+	case psExpStart:
+		if c == '+' || c == '-' {
+			p.expSign = int8(c-'+') / 2
+			p.state = psExpSign
+			return true
+		}
+		fallthrough
+	case psExpSign, psExp:
+		if c < '0' || c > '9' {
+			return false
+		}
+		p.state = psExp
+		if p.expVal < parseMaxExp {
+			p.expVal = 10*p.expVal + int(c-'0')
+		}
+	case psKeyword:
+		return p.keyword(c)
+	case psPayload:
+		if c < '0' || c > '9' {
+			return false
+		}
+		p.payloadDigit(c - '0')
 	}
 	return true
 }
 
-func (ctx Context) parseUint(s []byte) (uint64, int, error) {
-	var a uint64
-	var exp int
-	for i, c := range s {
-		if a >= decimalBase {
-			switch ctx.Rounding {
-			case HalfUp:
-				if c >= '5' {
-					a++
-				}
-			case HalfEven:
-				if c > '5' || c == '5' && (a%2 == 1 || !allZeros(s[i+1:])) {
-					a++
-				}
-			case Down:
-			default:
-				return 0, 0, fmt.Errorf("unsupported rounding mode: %v", ctx.Rounding)
-			}
-			exp = len(s) - i
-			break
-		}
-		a = 10*a + uint64(c-'0')
+// feedRune advances p past the UTF-8 encoding of r and reports whether r
+// continues the number. If it doesn't, p is unchanged.
+func (p *numParser) feedRune(r rune) bool {
+	if r < utf8.RuneSelf {
+		return p.feed(byte(r))
+	}
+	if r != '∞' || !p.feed("∞"[0]) {
+		return false
 	}
-	return a, exp, nil
+	return p.feed("∞"[1]) && p.feed("∞"[2])
 }
 
-type trie struct {
-	heads  []string
-	tails  tries
-	result int
+// intDigit adds a digit before the decimal point. At most 19 significant
+// digits are kept, which is enough to round correctly given the sticky flag.
+func (p *numParser) intDigit(x byte) {
+	switch {
+	case p.digits == 0 && x == 0:
+	case p.digits < 19:
+		p.coef = 10*p.coef + uint64(x)
+		p.digits++
+	default:
+		p.exp++
+		p.sticky = p.sticky || x != 0
+	}
 }
 
-func trieBranch(heads string, tails ...trie) trie {
-	return trie{heads: strings.Split(heads, "|"), tails: tails}
+// fracDigit adds a digit after the decimal point.
+func (p *numParser) fracDigit(x byte) {
+	switch {
+	case p.digits == 0 && x == 0:
+		p.exp--
+	case p.digits < 19:
+		p.coef = 10*p.coef + uint64(x)
+		p.digits++
+		p.exp--
+	default:
+		p.sticky = p.sticky || x != 0
+	}
 }
 
-func trieLeaf(heads string, result int) trie {
-	return trie{heads: strings.Split(heads, "|"), result: result}
+// payloadDigit adds a digit to a NaN payload, which is kept in coef.
+func (p *numParser) payloadDigit(x byte) {
+	if p.coef < decimalBase {
+		p.coef = 10*p.coef + uint64(x)
+	}
 }
 
-type tries []trie
-
-func (tt tries) Match(state fmt.ScanState) (int, error) {
-	for _, t := range tt {
-	heads:
-		for _, head := range t.heads {
-			for i, c := range head {
-				// Try to eat the head.
-				r, _, err := state.ReadRune()
-				if err != nil {
-					if err != io.EOF {
-						return 0, err
-					}
-					continue heads
-				}
-				if r != c {
-					if i > 0 {
-						return 0, errNotDecimal
-					}
-					if err := state.UnreadRune(); err != nil {
-						return 0, err
-					}
-					continue heads
-				}
+// keyword advances p past c if it continues a keyword, or starts a payload
+// after a complete NaN keyword.
+func (p *numParser) keyword(c byte) bool {
+	kw := p.kw[:p.kwLen]
+	for _, k := range keywords {
+		if len(k) > len(kw) && k[:len(kw)] == string(kw) && k[len(kw)] == c {
+			if p.kwLen == 0 {
+				p.kwAt = p.n
 			}
-			if len(t.tails) == 0 {
-				return t.result, nil
-			}
-			return t.tails.Match(state)
+			p.kw[p.kwLen] = c
+			p.kwLen++
+			p.state = psKeyword
+			return true
 		}
 	}
-	return 0, nil
+	// Every NaN keyword ends in 'n' or 'N' and no other keyword does.
+	if '0' <= c && c <= '9' && p.isKeyword() && (kw[len(kw)-1] == 'n' || kw[len(kw)-1] == 'N') {
+		p.state = psPayload
+		p.payloadDigit(c - '0')
+		return true
+	}
+	return false
 }
 
-var keywords = tries{
-	trieBranch("inf|Inf", trieLeaf("inity|", 1)),
-	trieLeaf("∞", 1),
-	trieBranch("s", trieLeaf("nan|NaN", 2)),
-	trieBranch("q|", trieLeaf("nan|NaN", 3)),
+// isKeyword reports whether p has read a complete keyword.
+func (p *numParser) isKeyword() bool {
+	for _, k := range keywords {
+		if k == string(p.kw[:p.kwLen]) {
+			return true
+		}
+	}
+	return false
 }
 
-func eatBytes(state fmt.ScanState, f func(r rune) bool) ([]byte, error) {
-	token, err := state.Token(false, f)
-	if err != nil {
-		return nil, err
+// syntaxError returns the reason the input p has read isn't a number, or
+// "" if it is one.
+func (p *numParser) syntaxError() string {
+	switch p.state {
+	case psStart, psSign, psPoint:
+		return "mantissa missing"
+	case psExpStart, psExpSign:
+		return "exponent value missing"
+	case psKeyword:
+		if !p.isKeyword() {
+			return errNotDecimal.Error()
+		}
+	case psPayload:
+		if p.coef >= decimalBase {
+			return "NaN payload too large"
+		}
 	}
-	return token, err
+	return ""
 }
 
-// eatRune returns 0 if it reads a, 1 if it reads b, -1 otherwise.
-func eatRune(state fmt.ScanState, a, b rune) (int, error) {
-	r, _, err := state.ReadRune()
-	if err != nil {
-		if err != io.EOF {
-			return 0, err
+// special reports whether p has read a keyword rather than digits.
+func (p *numParser) special() bool {
+	return p.state == psKeyword || p.state == psPayload
+}
+
+// value returns the number p has read, rounded with ctx, which must not have
+// a syntax error. It reports [ErrInexact] if rounding discarded nonzero
+// digits and [ErrRange] if the number overflowed to ±∞.
+func (p *numParser) value(ctx Context) (Decimal, error) {
+	if p.special() {
+		switch p.kw[0] {
+		case 'n', 'N', 'q':
+			return newPayloadNan(int(p.sign), flQNaN, p.coef), nil
+		case 's':
+			return newPayloadNan(int(p.sign), flSNaN, p.coef), nil
+		default:
+			return infinities[p.sign], nil
 		}
-		return -1, nil
-	}
-	if r == a {
-		return 0, nil
 	}
-	if r == b {
-		return 1, nil
+	exp := p.exp
+	if p.expSign == 1 {
+		exp -= p.expVal
+	} else {
+		exp += p.expVal
 	}
-	return -1, state.UnreadRune()
+	return ctx.newFromCoefficient(p.sign, exp, uint128T{lo: p.coef}, p.sticky)
+}
+
+// underflow reports whether p has read a nonzero number that value rounded
+// to zero.
+func (p *numParser) underflow(d Decimal) bool {
+	return !p.special() && (p.coef != 0 || p.sticky) && d.IsZero()
 }
 
 func newPayloadNan(sign int, fl flavor, weight uint64) Decimal {
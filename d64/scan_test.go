@@ -91,6 +91,77 @@ func TestParseLongMantissa(t *testing.T) {
 	parseEquals(NewFromInt64(123), "1230000000000000000000e-19")
 }
 
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+
+	parseEquals := parseEquals(t)
+
+	parseEquals(Inf, "1e500")
+	parseEquals(NegInf, "-1e385")
+	parseEquals(Max, "9.999999999999999e384")
+	parseEquals(Inf, "9.9999999999999995e384")
+	parseEquals(Zero, "1e-399")
+	parseEquals(Min, "1e-398")
+	parseEquals(MustParse("2e-398"), "1.5e-398")
+	parseEquals(MustParse("2e-398"), "2.5e-398")
+	parseEquals(Zero, "0.5e-398")
+	parseEquals(MustParse("1.2e-397"), "0.00012e-393")
+}
+
+func TestParseRounding(t *testing.T) {
+	t.Parallel()
+
+	parseEquals := parseEquals(t)
+
+	parseEquals(MustParse("1.000000000000000"), "1.0000000000000005")
+	parseEquals(MustParse("1.000000000000002"), "1.0000000000000015")
+	parseEquals(MustParse("1.000000000000001"), "1.00000000000000050000000000000000001")
+	parseEquals(MustParse("1234567890123457"), "12345678901234567.89e-1")
+	parseEquals(MustParse("1.234567890123457e39"), "1234567890123456789012345678901234567890.")
+
+	d, err := Context{Rounding: HalfUp}.Parse("1.0000000000000005")
+	isnil(t, err)
+	equalD64(t, MustParse("1.000000000000001"), d)
+}
+
+func TestParseKeywords(t *testing.T) {
+	t.Parallel()
+
+	parseEquals := parseEquals(t)
+
+	parseEquals(Inf, "infinity")
+	parseEquals(Inf, "+Infinity")
+	parseEquals(QNaN, "qNaN")
+	parseEquals(QNaN, "qnan")
+	parseEquals(SNaN, "sNaN")
+	parseEquals(newPayloadNan(1, flSNaN, 0), "-snan")
+	parseEquals(newPayloadNan(0, flQNaN, 123), "NaN123")
+	parseEquals(newPayloadNan(1, flSNaN, 999_999_999_999_999), "-sNaN999999999999999")
+
+	for _, s := range []string{"in", "infin", "infinit", "n", "qna", "s", "sinf", "NaN1000000000000000", "inf1", "∞∞", "x∞"} {
+		_, err := Parse(s)
+		notnil(t, err)
+	}
+}
+
+func TestDecimalScanStopsAtNonNumber(t *testing.T) {
+	t.Parallel()
+
+	var d Decimal
+	var s string
+	n, err := fmt.Sscanf("12.5e2x", "%g%s", &d, &s)
+	isnil(t, err)
+	equal(t, 2, n)
+	equalD64(t, MustParse("1250"), d)
+	equal(t, "x", s)
+
+	n, err = fmt.Sscanf("-∞ rest", "%g %s", &d, &s)
+	isnil(t, err)
+	equal(t, 2, n)
+	equalD64(t, NegInf, d)
+	equal(t, "rest", s)
+}
+
 func TestDecimalScanFlakyScanState(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,107 @@
+package d64
+
+import "strconv"
+
+// SciString returns d in scientific notation, as for the to-scientific-string
+// conversion of the General Decimal Arithmetic specification, e.g., "123.45",
+// "1.2345E+7", "-0", "Infinity" or "sNaN12".
+//
+// A [Decimal] doesn't preserve the exponent it was created with, so trailing
+// zeros are removed from the coefficient without raising a negative exponent
+// above zero, as for [Decimal.Decompose]. Thus "1.20" becomes "1.2" and
+// "1.2E+5" becomes "120000", but "1.2E+17", which has too many digits to be
+// an integer coefficient, is unchanged.
+func (d Decimal) SciString() string {
+	var buf [32]byte
+	return string(d.appendSci(buf[:0], false))
+}
+
+// EngString returns d in engineering notation, as for the
+// to-engineering-string conversion of the General Decimal Arithmetic
+// specification. It is like [Decimal.SciString], but exponents are multiples
+// of three, e.g., "12.345E+6".
+func (d Decimal) EngString() string {
+	var buf [32]byte
+	return string(d.appendSci(buf[:0], true))
+}
+
+// appendSci appends the to-scientific-string or, if eng is set, the
+// to-engineering-string conversion of d to buf.
+func (d Decimal) appendSci(buf []byte, eng bool) []byte {
+	var dp decParts
+	dp.unpack(d)
+	if dp.sign == 1 {
+		buf = append(buf, '-')
+	}
+	switch dp.fl {
+	case flInf:
+		return append(buf, "Infinity"...)
+	case flQNaN, flSNaN:
+		if dp.fl == flSNaN {
+			buf = append(buf, 's')
+		}
+		buf = append(buf, "NaN"...)
+		if payload := dp.significand.lo; payload != 0 {
+			buf = strconv.AppendUint(buf, payload, 10)
+		}
+		return buf
+	}
+
+	dp.trimZeros()
+	var digitBuf [20]byte
+	digits := strconv.AppendUint(digitBuf[:0], dp.significand.lo, 10)
+	exp := int(dp.exp)
+	if exp > 0 && len(digits)+exp <= decimalDigits {
+		digits = appendZeros(digits, exp)
+		exp = 0
+	}
+	adjusted := exp + len(digits) - 1
+
+	// point is the number of digits before the decimal point.
+	point := len(digits) + exp
+	switch {
+	case exp <= 0 && adjusted >= -6:
+		// Plain notation
+	case !eng:
+		point = 1
+	case dp.significand.lo == 0:
+		// Zero has as many zeros after the point as it takes to reach an
+		// exponent that is a multiple of three.
+		point = floorMod(point+1, 3) - 1
+	default:
+		point = floorMod(point-1, 3) + 1
+	}
+
+	switch {
+	case point <= 0:
+		buf = append(buf, '0', '.')
+		buf = appendZeros(buf, -point)
+		buf = append(buf, digits...)
+	case point >= len(digits):
+		buf = append(buf, digits...)
+		buf = appendZeros(buf, point-len(digits))
+	default:
+		buf = append(buf, digits[:point]...)
+		buf = append(buf, '.')
+		buf = append(buf, digits[point:]...)
+	}
+
+	if e := len(digits) + exp - point; e != 0 {
+		buf = append(buf, 'E')
+		if e > 0 {
+			buf = append(buf, '+')
+		}
+		buf = strconv.AppendInt(buf, int64(e), 10)
+	}
+	return buf
+}
+
+// floorMod returns x modulo m, which is positive, rounding the quotient
+// towards -∞.
+func floorMod(x, m int) int {
+	r := x % m
+	if r < 0 {
+		r += m
+	}
+	return r
+}
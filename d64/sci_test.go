@@ -0,0 +1,50 @@
+package d64
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestDecimalSciString(t *testing.T) {
+	t.Parallel()
+
+	test := func(sci, eng string, d Decimal) {
+		t.Helper()
+		equal(t, sci, d.SciString())
+		equal(t, eng, d.EngString())
+		equal(t, eng, string(d.Append(nil, 'n', 3)))
+	}
+	test("0", "0", Zero)
+	test("-0", "-0", NegZero)
+	test("1", "1", MustParse("1.00"))
+	test("123.45", "123.45", MustParse("123.45"))
+	test("-1200", "-1200", MustParse("-1.2e3"))
+	test("1000000000000000", "1000000000000000", MustParse("1e15"))
+	test("1E+16", "10E+15", MustParse("1e16"))
+	test("1.2E+17", "120E+15", MustParse("1.2e17"))
+	test("1.234567890123457E+20", "123.4567890123457E+18", MustParse("1.234567890123457e20"))
+	test("0.000001", "0.000001", MustParse("1e-6"))
+	test("1E-7", "100E-9", MustParse("1e-7"))
+	test("1.5E-10", "150E-12", MustParse("1.5e-10"))
+	test("1E-398", "10E-399", Min)
+	test("9.999999999999999E+384", "9.999999999999999E+384", Max)
+	test("Infinity", "Infinity", Inf)
+	test("-Infinity", "-Infinity", NegInf)
+	test("NaN", "NaN", QNaN)
+	test("sNaN", "sNaN", SNaN)
+	test("-NaN12", "-NaN12", MustParse("-NaN12"))
+
+	// Arithmetic results may hold an integer with a positive exponent.
+	test("1000000000000000", "1000000000000000", MustParse("1e14").Mul(NewFromInt64(10)))
+}
+
+func TestDecimalSciStringAllocs(t *testing.T) {
+	if unsafe.Sizeof(Zero) != unsafe.Sizeof(uint64(0)) {
+		t.Skip("decimal_debug builds allocate debug strings")
+	}
+	d := MustParse("-1.234567890123456e-300")
+	var buf [32]byte
+	equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		_ = d.Append(buf[:0], 'n', -1)
+	}))
+}
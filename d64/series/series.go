@@ -0,0 +1,214 @@
+// Package series compresses sequences of [d64.Decimal] values, such as time
+// series of prices and balances, that mostly share an exponent and change
+// by small steps.
+//
+// # Format
+//
+// Values are encoded in blocks. Each block starts with the number of values
+// as a uvarint and a common exponent E as a varint. Each value is then a
+// uvarint token. If the token's low bit is zero, the rest of the token is the
+// zigzag-encoded difference between the value's coefficient at exponent E
+// and that of the previous such value in the block, starting from zero.
+// If the low bit is one, the token is followed by the 8-byte binary encoding
+// of the value, as returned by [d64.Decimal.MarshalBinary].
+//
+// E is the smallest exponent of the finite values in the block, with
+// trailing zeros removed from their coefficients. Values that can't be
+// represented exactly at exponent E with a coefficient below 2⁶², as well as
+// NaNs, infinities and -0, use the 8-byte form. Decoding is always bit-exact.
+//
+// A stream is a sequence of blocks with nothing in between.
+package series
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/anz-bank/decimal/d64"
+)
+
+const (
+	maxCoefficient = 1 << 62
+	rawToken       = 1
+)
+
+// Append appends values to buf as a single block.
+func Append(buf []byte, values []d64.Decimal) []byte {
+	exp := commonExponent(values)
+	buf = binary.AppendUvarint(buf, uint64(len(values)))
+	buf = binary.AppendVarint(buf, int64(exp))
+
+	var prev int64
+	for _, d := range values {
+		if c, ok := coefficientAt(d, exp); ok {
+			buf = binary.AppendUvarint(buf, zigzag(c-prev)<<1)
+			prev = c
+			continue
+		}
+		buf = binary.AppendUvarint(buf, rawToken)
+		buf, _ = d.AppendBinary(buf)
+	}
+	return buf
+}
+
+// Decode decodes all the blocks in b and appends the values to dst.
+func Decode(dst []d64.Decimal, b []byte) ([]d64.Decimal, error) {
+	r := bytes.NewReader(b)
+	for r.Len() > 0 {
+		var err error
+		if dst, err = decodeBlock(dst, r); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+// ErrCorrupt is reported when encoded data is malformed.
+var ErrCorrupt = errors.New("series: corrupt data")
+
+// blockReader is satisfied by [bytes.Reader] and [bufio.Reader].
+type blockReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// decodeBlock decodes one block from r and appends the values to dst.
+// It returns [io.EOF] if r is empty.
+func decodeBlock(dst []d64.Decimal, r blockReader) ([]d64.Decimal, error) {
+	n, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		return dst, err
+	} else if err != nil {
+		return dst, corrupt(err)
+	}
+	e, err := binary.ReadVarint(r)
+	if err != nil {
+		return dst, corrupt(err)
+	}
+	if e < -(1<<16) || e > 1<<16 {
+		return dst, fmt.Errorf("%w: exponent %d", ErrCorrupt, e)
+	}
+	exp := int32(e)
+
+	var prev int64
+	var raw [8]byte
+	for ; n > 0; n-- {
+		t, err := binary.ReadUvarint(r)
+		if err != nil {
+			return dst, corrupt(err)
+		}
+		var d d64.Decimal
+		switch {
+		case t == rawToken:
+			if _, err := io.ReadFull(r, raw[:]); err != nil {
+				return dst, corrupt(err)
+			}
+			if err := d.UnmarshalBinary(raw[:]); err != nil {
+				return dst, corrupt(err)
+			}
+		case t&1 == 0:
+			prev += unzigzag(t >> 1)
+			if d, err = compose(prev, exp); err != nil {
+				return dst, fmt.Errorf("%w: %v", ErrCorrupt, err)
+			}
+		default:
+			return dst, fmt.Errorf("%w: token %d", ErrCorrupt, t)
+		}
+		dst = append(dst, d)
+	}
+	return dst, nil
+}
+
+func corrupt(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("%w: %v", ErrCorrupt, err)
+}
+
+// decompose returns the sign, coefficient and exponent of a finite d.
+func decompose(d d64.Decimal) (neg bool, c uint64, exp int32, ok bool) {
+	var buf [8]byte
+	form, neg, coefficient, exp := d.Decompose(buf[:0])
+	if form != d64.FormFinite {
+		return false, 0, 0, false
+	}
+	for _, b := range coefficient {
+		c = c<<8 | uint64(b)
+	}
+	return neg, c, exp, true
+}
+
+// commonExponent returns the smallest exponent of the finite nonzero
+// values, or zero if there are none.
+func commonExponent(values []d64.Decimal) int32 {
+	var exp int32
+	found := false
+	for _, d := range values {
+		if _, c, e, ok := decompose(d); ok && c != 0 && (!found || e < exp) {
+			exp, found = e, true
+		}
+	}
+	return exp
+}
+
+// coefficientAt returns the signed coefficient of d at exponent exp, if d
+// can be represented exactly and bit-exactly that way.
+func coefficientAt(d d64.Decimal, exp int32) (int64, bool) {
+	neg, c, e, ok := decompose(d)
+	if !ok || e < exp || e-exp > 19 {
+		return 0, false
+	}
+	hi, lo := bits.Mul64(c, pow10(int(e-exp)))
+	if hi != 0 || lo >= maxCoefficient {
+		return 0, false
+	}
+	s := int64(lo)
+	if neg {
+		s = -s
+	}
+
+	// Check that decoding reproduces d exactly, which rules out -0.
+	f, err := compose(s, exp)
+	if err != nil {
+		return 0, false
+	}
+	var x, y [8]byte
+	a, _ := d.AppendBinary(x[:0])
+	b, _ := f.AppendBinary(y[:0])
+	return s, bytes.Equal(a, b)
+}
+
+// compose returns c × 10^exp.
+func compose(c int64, exp int32) (d64.Decimal, error) {
+	neg := c < 0
+	u := uint64(c)
+	if neg {
+		u = -u
+	}
+	var coefficient [8]byte
+	binary.BigEndian.PutUint64(coefficient[:], u)
+	var d d64.Decimal
+	err := d.Compose(d64.FormFinite, neg, coefficient[:], exp)
+	return d, err
+}
+
+func pow10(n int) uint64 {
+	p := uint64(1)
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
+}
+
+func zigzag(i int64) uint64 {
+	return uint64(i<<1) ^ uint64(i>>63)
+}
+
+func unzigzag(u uint64) int64 {
+	return int64(u>>1) ^ -int64(u&1)
+}
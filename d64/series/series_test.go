@@ -0,0 +1,126 @@
+package series
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/anz-bank/decimal/d64"
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func parse(ss ...string) []d64.Decimal {
+	values := make([]d64.Decimal, 0, len(ss))
+	for _, s := range ss {
+		values = append(values, d64.MustParse(s))
+	}
+	return values
+}
+
+// bitsEqual reports whether a and b hold identical encodings.
+func bitsEqual(t *testing.T, a, b []d64.Decimal) {
+	t.Helper()
+	expect.Equal(t, len(a), len(b))
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, _ := a[i].MarshalBinary()
+		y, _ := b[i].MarshalBinary()
+		if string(x) != string(y) {
+			t.Errorf("value %d: expected %v (%x), got %v (%x)", i, a[i], x, b[i], y)
+		}
+	}
+}
+
+func TestAppend(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, values []d64.Decimal) {
+		t.Helper()
+		b := Append(nil, values)
+		expect.Equal(t, expected, fmt.Sprintf("%x", b))
+		got, err := Decode(nil, b)
+		expect.Nil(t, err)
+		bitsEqual(t, values, got)
+	}
+
+	test("0000", nil)
+	test("0403ac0334080e", parse("1.07", "1.20", "1.22", "1.18"))
+	test("02000410", parse("1", "5"))
+	test("03000028e802", parse("0", "10", "100"))
+	test("02011234", parse("-0.5", "0.8"))
+	test("010001b1c0000000000000", parse("-0"))
+}
+
+func TestAppendRaw(t *testing.T) {
+	t.Parallel()
+
+	negNaN := d64.QNaN.Neg()
+	payload := d64.QNaN
+	if err := payload.UnmarshalBinary([]byte{0x7c, 0, 0, 0, 0, 0, 0x12, 0x34}); err != nil {
+		t.Fatal(err)
+	}
+	values := []d64.Decimal{
+		d64.MustParse("1.5"),
+		d64.QNaN, d64.SNaN, negNaN, payload,
+		d64.Inf, d64.NegInf, d64.NegZero, d64.Zero,
+		d64.Max, d64.Min, d64.Max.Neg(), d64.Min.Neg(),
+		d64.MustParse("1e-300"), d64.MustParse("1e300"),
+		d64.MustParse("1234567890123456"), d64.MustParse("-2.5"),
+	}
+	got, err := Decode(nil, Append(nil, values))
+	expect.Nil(t, err)
+	bitsEqual(t, values, got)
+}
+
+func TestAppendCompresses(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	values := make([]d64.Decimal, 1000)
+	price := int64(10000)
+	for i := range values {
+		price += r.Int63n(21) - 10
+		values[i] = d64.NewFromInt64(price).ScaleB(d64.NewFromInt64(-2))
+	}
+	b := Append(nil, values)
+	if len(b) > 2*len(values) {
+		t.Errorf("%d values encoded as %d bytes", len(values), len(b))
+	}
+	got, err := Decode(nil, b)
+	expect.Nil(t, err)
+	bitsEqual(t, values, got)
+}
+
+func TestAppendRandom(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(2))
+	values := make([]d64.Decimal, 500)
+	var buf [8]byte
+	for i := range values {
+		r.Read(buf[:])
+		if err := values[i].UnmarshalBinary(buf[:]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var b []byte
+	for i := 0; i < len(values); i += 100 {
+		b = Append(b, values[i:i+100])
+	}
+	got, err := Decode(nil, b)
+	expect.Nil(t, err)
+	bitsEqual(t, values, got)
+}
+
+func TestDecodeCorrupt(t *testing.T) {
+	t.Parallel()
+
+	b := Append(nil, parse("1.07", "1.20", "NaN"))
+	for i := 1; i < len(b); i++ {
+		_, err := Decode(nil, b[:i])
+		expect.ErrorIs(t, err, ErrCorrupt)
+	}
+	_, err := Decode(nil, []byte{1, 0, 3})
+	expect.ErrorIs(t, err, ErrCorrupt)
+	_, err = Decode(nil, []byte{1, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x02, 0})
+	expect.ErrorIs(t, err, ErrCorrupt)
+}
@@ -0,0 +1,85 @@
+package series
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/anz-bank/decimal/d64"
+)
+
+// DefaultBlockSize is the number of values an [Encoder] writes per block
+// unless its BlockSize is set.
+const DefaultBlockSize = 1024
+
+// An Encoder writes a stream of values to an [io.Writer] in blocks.
+type Encoder struct {
+	// BlockSize is the number of values buffered before a block is written.
+	// If it is zero or negative, DefaultBlockSize is used.
+	BlockSize int
+
+	w      io.Writer
+	values []d64.Decimal
+	buf    []byte
+}
+
+// NewEncoder returns an Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode adds d to the stream, writing a block if the buffer is full.
+func (e *Encoder) Encode(d d64.Decimal) error {
+	e.values = append(e.values, d)
+	if len(e.values) >= e.blockSize() {
+		return e.Flush()
+	}
+	return nil
+}
+
+// Flush writes any buffered values as a block.
+func (e *Encoder) Flush() error {
+	if len(e.values) == 0 {
+		return nil
+	}
+	e.buf = Append(e.buf[:0], e.values)
+	e.values = e.values[:0]
+	_, err := e.w.Write(e.buf)
+	return err
+}
+
+func (e *Encoder) blockSize() int {
+	if e.BlockSize <= 0 {
+		return DefaultBlockSize
+	}
+	return e.BlockSize
+}
+
+// A Decoder reads a stream of values written by an [Encoder] or [Append].
+type Decoder struct {
+	r      *bufio.Reader
+	values []d64.Decimal
+	next   int
+}
+
+// NewDecoder returns a Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Decode returns the next value in the stream. At the end of the stream it
+// returns [io.EOF]. If the stream ends partway through a block, or is
+// otherwise malformed, it returns an error wrapping [ErrCorrupt].
+func (d *Decoder) Decode() (d64.Decimal, error) {
+	for d.next == len(d.values) {
+		var err error
+		d.values, err = decodeBlock(d.values[:0], d.r)
+		d.next = 0
+		if err != nil {
+			d.values = d.values[:0]
+			return d64.Zero, err
+		}
+	}
+	v := d.values[d.next]
+	d.next++
+	return v, nil
+}
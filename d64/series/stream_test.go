@@ -0,0 +1,60 @@
+package series
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func TestEncoderDecoder(t *testing.T) {
+	t.Parallel()
+
+	values := parse("1.07", "1.20", "NaN", "-0", "1.22", "inf", "1.18", "1e300", "0")
+	for _, size := range []int{0, 1, 2, 4, len(values)} {
+		var buf bytes.Buffer
+		e := NewEncoder(&buf)
+		e.BlockSize = size
+		for _, d := range values {
+			expect.Nil(t, e.Encode(d))
+		}
+		expect.Nil(t, e.Flush())
+		expect.Nil(t, e.Flush())
+
+		got, err := Decode(nil, buf.Bytes())
+		expect.Nil(t, err)
+		bitsEqual(t, values, got)
+
+		dec := NewDecoder(&buf)
+		got = got[:0]
+		for {
+			d, err := dec.Decode()
+			if err == io.EOF {
+				break
+			}
+			expect.Nil(t, err)
+			got = append(got, d)
+		}
+		bitsEqual(t, values, got)
+	}
+}
+
+func TestDecoderCorrupt(t *testing.T) {
+	t.Parallel()
+
+	b := Append(nil, parse("1.07", "1.20"))
+	dec := NewDecoder(bytes.NewReader(b[:len(b)-1]))
+	_, err := dec.Decode()
+	expect.ErrorIs(t, err, ErrCorrupt)
+}
+
+func TestEncoderEmpty(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	expect.Nil(t, NewEncoder(&buf).Flush())
+	expect.Equal(t, 0, buf.Len())
+	_, err := NewDecoder(&buf).Decode()
+	expect.Equal(t, io.EOF, err)
+}
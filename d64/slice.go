@@ -0,0 +1,158 @@
+package d64
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// sliceChunk is the number of values [SliceOptions.Write] and
+// [SliceOptions.Read] move through their buffer at a time.
+const sliceChunk = 512
+
+// SliceOptions controls how slices of [Decimal] values are encoded as
+// packed 8-byte words, as for [AppendBinarySlice].
+type SliceOptions struct {
+	// ByteOrder is the order of bytes within each word. If it is nil,
+	// [binary.BigEndian] is used, as for [Decimal.MarshalBinary].
+	ByteOrder binary.ByteOrder
+
+	// Validate makes decoding report an error for non-canonical encodings.
+	// See [SliceOptions.Decode].
+	Validate bool
+}
+
+// DefaultSliceOptions is used by [AppendBinarySlice] and
+// [DecodeBinarySlice]. It uses big-endian words and doesn't validate.
+var DefaultSliceOptions = SliceOptions{}
+
+// AppendBinarySlice appends the binary encoding of each value in ds, as
+// returned by [Decimal.MarshalBinary], to dst.
+// It uses [DefaultSliceOptions].
+func AppendBinarySlice(dst []byte, ds []Decimal) []byte {
+	return DefaultSliceOptions.Append(dst, ds)
+}
+
+// DecodeBinarySlice decodes the values encoded in b, as appended by
+// [AppendBinarySlice], and appends them to dst.
+// It uses [DefaultSliceOptions].
+func DecodeBinarySlice(dst []Decimal, b []byte) ([]Decimal, error) {
+	return DefaultSliceOptions.Decode(dst, b)
+}
+
+func (o SliceOptions) byteOrder() binary.ByteOrder {
+	if o.ByteOrder == nil {
+		return binary.BigEndian
+	}
+	return o.ByteOrder
+}
+
+// Append appends each value in ds to dst as an 8-byte word. It grows dst at
+// most once.
+func (o SliceOptions) Append(dst []byte, ds []Decimal) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, 8*len(ds))[:n+8*len(ds)]
+	o.put(dst[n:], ds)
+	return dst
+}
+
+func (o SliceOptions) put(b []byte, ds []Decimal) {
+	bo := o.byteOrder()
+	for i, d := range ds {
+		bo.PutUint64(b[8*i:], d.bits)
+	}
+}
+
+// Decode decodes the 8-byte words in b and appends the values to dst. It
+// grows dst at most once. It reports an error if the length of b isn't a
+// multiple of 8.
+//
+// If o.Validate is set, Decode also reports an error for any encoding that
+// isn't canonical in IEEE 754 terms: finite numbers whose coefficient
+// exceeds 16 digits, ±∞ with any bits set besides the sign and the
+// infinity pattern, and NaNs with a payload of 10¹⁵ or more or with
+// unused bits set. On error, dst is returned unchanged.
+func (o SliceOptions) Decode(dst []Decimal, b []byte) ([]Decimal, error) {
+	if len(b)%8 != 0 {
+		return dst, fmt.Errorf("binary slice length %d not a multiple of 8", len(b))
+	}
+	n := len(dst)
+	dst = slices.Grow(dst, len(b)/8)[:n+len(b)/8]
+	if _, err := o.get(dst[n:], b, 0); err != nil {
+		return dst[:n], err
+	}
+	return dst, nil
+}
+
+// get decodes len(ds) words from b into ds and returns the number decoded.
+// base is the index of ds[0] in the whole slice, for error messages.
+func (o SliceOptions) get(ds []Decimal, b []byte, base int) (int, error) {
+	bo := o.byteOrder()
+	for i := range ds {
+		bits := bo.Uint64(b[8*i:])
+		if o.Validate && !canonical(bits) {
+			return i, fmt.Errorf("non-canonical decimal %#016x at index %d", bits, base+i)
+		}
+		ds[i] = newDec(bits)
+	}
+	return len(ds), nil
+}
+
+// Write writes each value in ds to w as an 8-byte word.
+// It returns the number of bytes written.
+func (o SliceOptions) Write(w io.Writer, ds []Decimal) (int64, error) {
+	var buf [8 * sliceChunk]byte
+	var written int64
+	for len(ds) > 0 {
+		n := min(len(ds), sliceChunk)
+		o.put(buf[:], ds[:n])
+		m, err := w.Write(buf[:8*n])
+		written += int64(m)
+		if err != nil {
+			return written, err
+		}
+		ds = ds[n:]
+	}
+	return written, nil
+}
+
+// Read reads 8-byte words from r into ds until ds is full and returns the
+// number of values read. It reports [io.EOF] if no bytes were read and
+// [io.ErrUnexpectedEOF] if r ends partway through ds. If o.Validate is set,
+// it reports an error for non-canonical encodings, as for
+// [SliceOptions.Decode], and the count excludes the offending value.
+func (o SliceOptions) Read(r io.Reader, ds []Decimal) (int, error) {
+	var buf [8 * sliceChunk]byte
+	total := 0
+	for total < len(ds) {
+		n := min(len(ds)-total, sliceChunk)
+		m, err := io.ReadFull(r, buf[:8*n])
+		m, verr := o.get(ds[total:total+m/8], buf[:], total)
+		total += m
+		if verr != nil {
+			return total, verr
+		}
+		if err != nil {
+			if err == io.EOF && total > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// canonical reports whether bits is a canonical decimal64 encoding.
+func canonical(bits uint64) bool {
+	switch newNostr(bits).flavor() {
+	case flNormal51:
+		return bits&(1<<51-1)|1<<53 <= maxSig
+	case flInf:
+		return bits&^neg == inf
+	case flQNaN, flSNaN:
+		payload := bits & d64PayloadMask
+		return bits&^(neg|nanMask|d64PayloadMask) == 0 && payload < decimalBase
+	}
+	return true
+}
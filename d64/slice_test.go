@@ -0,0 +1,131 @@
+package d64
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+	"unsafe"
+)
+
+func TestAppendBinarySlice(t *testing.T) {
+	t.Parallel()
+
+	ds := []Decimal{One, MustParse("-12.5"), Zero, NegZero, Inf, NegInf, QNaN, SNaN, Max, Min}
+	b := AppendBinarySlice([]byte("x"), ds)
+	equal(t, 1+8*len(ds), len(b))
+	for i, d := range ds {
+		m, _ := d.MarshalBinary()
+		equal(t, string(m), string(b[1+8*i:9+8*i]))
+	}
+
+	got, err := DecodeBinarySlice([]Decimal{Pi}, b[1:])
+	isnil(t, err)
+	equal(t, len(ds)+1, len(got))
+	equal(t, Pi.bits, got[0].bits)
+	for i, d := range ds {
+		equal(t, d.bits, got[i+1].bits)
+	}
+
+	_, err = DecodeBinarySlice(nil, b[:7])
+	notnil(t, err)
+}
+
+func TestSliceOptionsLittleEndian(t *testing.T) {
+	t.Parallel()
+
+	o := SliceOptions{ByteOrder: binary.LittleEndian}
+	b := o.Append(nil, []Decimal{One})
+	equal(t, "\x00\x80\xc6\xa4\x7e\x8d\xe3\x2f", string(b))
+	ds, err := o.Decode(nil, b)
+	isnil(t, err)
+	equalD64(t, One, ds[0])
+}
+
+func TestSliceOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	o := SliceOptions{Validate: true}
+	valid := func(bits uint64) {
+		t.Helper()
+		_, err := o.Decode(nil, binary.BigEndian.AppendUint64(nil, bits))
+		isnil(t, err)
+	}
+	invalid := func(bits uint64) {
+		t.Helper()
+		b := binary.BigEndian.AppendUint64(AppendBinarySlice(nil, []Decimal{One}), bits)
+		ds, err := o.Decode([]Decimal{Pi}, b)
+		notnil(t, err)
+		equal(t, 1, len(ds))
+
+		ds = make([]Decimal, 2)
+		n, err := o.Read(bytes.NewReader(b), ds)
+		notnil(t, err)
+		equal(t, 1, n)
+
+		_, err = DecodeBinarySlice(nil, b)
+		isnil(t, err)
+	}
+
+	for _, d := range []Decimal{One, Zero, NegZero, Max, Min, Inf, NegInf, QNaN, SNaN, QNaN.Neg()} {
+		valid(d.bits)
+	}
+	valid(QNaN.bits | 999_999_999_999_999)
+	valid(0x6c7386f26fc0ffff) // 9999999999999999
+
+	invalid(0x6c7386f26fc10000) // 10000000000000000
+	invalid(0x7fffffffffffffff)
+	invalid(inf | 1)
+	invalid(QNaN.bits | 1_000_000_000_000_000)
+	invalid(QNaN.bits | 1<<50)
+}
+
+func TestSliceOptionsWriteRead(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	ds := make([]Decimal, 3*sliceChunk+7)
+	for i := range ds {
+		ds[i] = newDec(r.Uint64())
+	}
+	for _, o := range []SliceOptions{{}, {ByteOrder: binary.LittleEndian}} {
+		var buf bytes.Buffer
+		n, err := o.Write(&buf, ds)
+		isnil(t, err)
+		equal(t, int64(8*len(ds)), n)
+		equal(t, string(o.Append(nil, ds)), buf.String())
+
+		got := make([]Decimal, len(ds))
+		m, err := o.Read(&buf, got)
+		isnil(t, err)
+		equal(t, len(ds), m)
+		for i := range ds {
+			equal(t, ds[i].bits, got[i].bits)
+		}
+
+		m, err = o.Read(&buf, got)
+		equal(t, 0, m)
+		equal(t, io.EOF, err)
+	}
+
+	b := AppendBinarySlice(nil, ds[:3])
+	m, err := DefaultSliceOptions.Read(bytes.NewReader(b[:20]), make([]Decimal, 3))
+	equal(t, 2, m)
+	check(t, errors.Is(err, io.ErrUnexpectedEOF))
+}
+
+func TestAppendBinarySliceAllocs(t *testing.T) {
+	if unsafe.Sizeof(Zero) != unsafe.Sizeof(uint64(0)) {
+		t.Skip("decimal_debug builds allocate debug strings")
+	}
+	ds := make([]Decimal, 1000)
+	b := make([]byte, 0, 8*len(ds))
+	out := make([]Decimal, 0, len(ds))
+	allocs := testing.AllocsPerRun(10, func() {
+		b = AppendBinarySlice(b[:0], ds)
+		out, _ = DecodeBinarySlice(out[:0], b)
+	})
+	equal(t, 0.0, allocs)
+}
@@ -0,0 +1,145 @@
+package d64
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+var _ driver.Valuer = Zero
+
+// NullPolicy controls how a SQL NULL is scanned into a [Decimal].
+type NullPolicy int8
+
+const (
+	// NullError reports an error when scanning a NULL.
+	NullError NullPolicy = iota
+
+	// NullZero scans a NULL as [Zero].
+	NullZero
+
+	// NullNaN scans a NULL as [QNaN].
+	NullNaN
+)
+
+func (p NullPolicy) String() string {
+	switch p {
+	case NullError:
+		return "NullError"
+	case NullZero:
+		return "NullZero"
+	case NullNaN:
+		return "NullNaN"
+	default:
+		return fmt.Sprintf("Unknown null policy %d", p)
+	}
+}
+
+// SQLOptions controls how a [Decimal] is exchanged with database/sql.
+type SQLOptions struct {
+	// Context is used to round text and integer values with more than 16
+	// significant digits.
+	Context Context
+
+	// Null selects how a NULL is scanned.
+	Null NullPolicy
+
+	// Binary causes values to be emitted as the 8-byte big-endian form
+	// produced by [Decimal.MarshalBinary] instead of text. When set, []byte
+	// sources are also scanned in that form.
+	Binary bool
+}
+
+// DefaultSQLOptions is used by [Decimal.Value] and [SQLScanner].
+// It emits text, rejects NULLs and rounds with [DefaultScanContext].
+var DefaultSQLOptions = SQLOptions{Context: DefaultScanContext}
+
+// Value implements [driver.Valuer].
+// It uses [DefaultSQLOptions].
+func (d Decimal) Value() (driver.Value, error) {
+	return DefaultSQLOptions.Value(d)
+}
+
+// Value converts d to a [driver.Value].
+// It returns the exact text form of d, or its binary form if o.Binary is set.
+func (o SQLOptions) Value(d Decimal) (driver.Value, error) {
+	if o.Binary {
+		return d.MarshalBinary()
+	}
+	return d.String(), nil
+}
+
+// Scan assigns a database value to d.
+// It accepts string, []byte, int64, float64 and nil, the last according to
+// o.Null.
+func (o SQLOptions) Scan(d *Decimal, src any) error {
+	switch src := src.(type) {
+	case string:
+		return o.scanText(d, src)
+	case []byte:
+		if o.Binary {
+			if len(src) != 8 {
+				return fmt.Errorf("binary Decimal must be 8 bytes, got %d", len(src))
+			}
+			return d.UnmarshalBinary(src)
+		}
+		return o.scanText(d, string(src))
+	case int64:
+		if -int64(maxSig) <= src && src <= int64(maxSig) {
+			*d = NewFromInt64(src)
+			return nil
+		}
+		return o.scanText(d, strconv.FormatInt(src, 10))
+	case float64:
+		*d = NewFromFloat64(src)
+		return nil
+	case nil:
+		switch o.Null {
+		case NullZero:
+			*d = Zero
+		case NullNaN:
+			*d = QNaN
+		default:
+			return fmt.Errorf("cannot scan NULL into Decimal")
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into Decimal", src)
+	}
+}
+
+func (o SQLOptions) scanText(d *Decimal, s string) error {
+	e, err := o.Context.Parse(s)
+	if err != nil {
+		return err
+	}
+	*d = e
+	return nil
+}
+
+// Scanner returns an [sql.Scanner] that scans into d using o.
+func (o SQLOptions) Scanner(d *Decimal) sql.Scanner {
+	return sqlScanner{d, o}
+}
+
+// SQLScanner returns an [sql.Scanner] that scans into d using
+// [DefaultSQLOptions].
+// It is needed because [Decimal.Scan] implements [fmt.Scanner], which
+// prevents Decimal from implementing sql.Scanner directly.
+//
+//	var balance d64.Decimal
+//	err := row.Scan(d64.SQLScanner(&balance))
+func SQLScanner(d *Decimal) sql.Scanner {
+	return DefaultSQLOptions.Scanner(d)
+}
+
+type sqlScanner struct {
+	d    *Decimal
+	opts SQLOptions
+}
+
+// Scan implements [sql.Scanner].
+func (s sqlScanner) Scan(src any) error {
+	return s.opts.Scan(s.d, src)
+}
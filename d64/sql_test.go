@@ -0,0 +1,64 @@
+package d64
+
+import "testing"
+
+func TestDecimalValue(t *testing.T) {
+	t.Parallel()
+
+	v, err := MustParse("123.45").Value()
+	isnil(t, err)
+	equal(t, any("123.45"), v)
+
+	v, err = SQLOptions{Binary: true}.Value(One)
+	isnil(t, err)
+	data, _ := One.MarshalBinary()
+	equal(t, string(data), string(v.([]byte)))
+}
+
+func TestDecimalScanSQL(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected Decimal, src any) {
+		t.Helper()
+		var d Decimal
+		isnil(t, SQLScanner(&d).Scan(src))
+		equalD64(t, expected, d)
+	}
+
+	test(MustParse("123.45"), "123.45")
+	test(MustParse("-0.001"), []byte("-0.001"))
+	test(NewFromInt64(42), int64(42))
+	test(MustParse("9.223372036854776e18"), int64(9223372036854775807))
+	test(MustParse("1.5"), 1.5)
+	test(Inf, "Infinity")
+
+	var d Decimal
+	notnil(t, SQLScanner(&d).Scan(nil))
+	notnil(t, SQLScanner(&d).Scan("omg"))
+	notnil(t, SQLScanner(&d).Scan(true))
+}
+
+func TestDecimalScanSQLNull(t *testing.T) {
+	t.Parallel()
+
+	d := One
+	isnil(t, SQLOptions{Null: NullZero}.Scanner(&d).Scan(nil))
+	equal(t, Zero, d)
+
+	isnil(t, SQLOptions{Null: NullNaN}.Scanner(&d).Scan(nil))
+	check(t, d.IsQNaN())
+}
+
+func TestDecimalScanSQLBinary(t *testing.T) {
+	t.Parallel()
+
+	opts := SQLOptions{Binary: true}
+	v, err := opts.Value(Pi)
+	isnil(t, err)
+
+	var d Decimal
+	isnil(t, opts.Scanner(&d).Scan(v))
+	equal(t, Pi, d)
+
+	notnil(t, opts.Scanner(&d).Scan([]byte{1, 2, 3}))
+}
@@ -0,0 +1,266 @@
+package d64
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// maxUnscaledDigits is the most digits an unscaled value may have. Every
+// 38-digit integer fits in a signed 128-bit integer.
+const maxUnscaledDigits = 38
+
+// Unscaled128 returns d × 10^scale, rounded to an integer with
+// [DefaultContext], as a signed 128-bit integer split into its high and
+// low 64 bits. This is the representation used by Arrow's decimal128 type.
+func (d Decimal) Unscaled128(scale int) (hi int64, lo uint64, err error) {
+	return DefaultContext.Unscaled128(d, scale)
+}
+
+// Unscaled64 returns d × 10^scale, rounded to an integer with
+// [DefaultContext]. This is Parquet's INT64 physical type for DECIMAL.
+func (d Decimal) Unscaled64(scale int) (int64, error) {
+	return DefaultContext.Unscaled64(d, scale)
+}
+
+// Unscaled32 returns d × 10^scale, rounded to an integer with
+// [DefaultContext]. This is Parquet's INT32 physical type for DECIMAL.
+func (d Decimal) Unscaled32(scale int) (int32, error) {
+	return DefaultContext.Unscaled32(d, scale)
+}
+
+// AppendUnscaled appends d × 10^scale, rounded to an integer with
+// [DefaultContext], to buf as a big-endian two's complement integer.
+// See [Context.AppendUnscaled].
+func (d Decimal) AppendUnscaled(buf []byte, scale, size int) ([]byte, error) {
+	return DefaultContext.AppendUnscaled(buf, d, scale, size)
+}
+
+// Unscaled128 returns d × 10^scale, rounded to an integer with ctx, as a
+// signed 128-bit integer split into its high and low 64 bits.
+// It reports an error wrapping [ErrRange] if the result has more than 38
+// digits, and an error if d is NaN or ±∞.
+func (ctx Context) Unscaled128(d Decimal, scale int) (hi int64, lo uint64, err error) {
+	neg, u, err := ctx.unscaled(d, scale)
+	if err != nil {
+		return 0, 0, err
+	}
+	if neg {
+		u.sub(&uint128T{}, &u)
+	}
+	return int64(u.hi), u.lo, nil
+}
+
+// Unscaled64 returns d × 10^scale, rounded to an integer with ctx.
+// It reports an error wrapping [ErrRange] if the result doesn't fit in an
+// int64, and an error if d is NaN or ±∞.
+func (ctx Context) Unscaled64(d Decimal, scale int) (int64, error) {
+	neg, u, err := ctx.unscaled(d, scale)
+	if err != nil {
+		return 0, err
+	}
+	if u.hi != 0 || u.lo > math.MaxInt64+1 || u.lo == math.MaxInt64+1 && !neg {
+		return 0, fmt.Errorf("%v at scale %d overflows int64: %w", d, scale, ErrRange)
+	}
+	if neg {
+		return -int64(u.lo), nil
+	}
+	return int64(u.lo), nil
+}
+
+// Unscaled32 returns d × 10^scale, rounded to an integer with ctx.
+// It reports an error wrapping [ErrRange] if the result doesn't fit in an
+// int32, and an error if d is NaN or ±∞.
+func (ctx Context) Unscaled32(d Decimal, scale int) (int32, error) {
+	i, err := ctx.Unscaled64(d, scale)
+	if err != nil {
+		return 0, err
+	}
+	if i < math.MinInt32 || i > math.MaxInt32 {
+		return 0, fmt.Errorf("%v at scale %d overflows int32: %w", d, scale, ErrRange)
+	}
+	return int32(i), nil
+}
+
+// AppendUnscaled appends d × 10^scale, rounded to an integer with ctx, to
+// buf as a big-endian two's complement integer. This is the representation
+// used by Avro's decimal logical type and Parquet's FIXED_LEN_BYTE_ARRAY and
+// BINARY physical types for DECIMAL.
+//
+// If size is zero, the minimum number of bytes is used, as for Avro bytes.
+// Otherwise, exactly size bytes are used, as for Avro fixed, and an error
+// wrapping [ErrRange] is reported if the integer doesn't fit.
+// The integer may have at most 38 digits.
+func (ctx Context) AppendUnscaled(buf []byte, d Decimal, scale, size int) ([]byte, error) {
+	if size < 0 {
+		return buf, fmt.Errorf("invalid unscaled size %d", size)
+	}
+	hi, lo, err := ctx.Unscaled128(d, scale)
+	if err != nil {
+		return buf, err
+	}
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], uint64(hi))
+	binary.BigEndian.PutUint64(b[8:], lo)
+
+	// Strip bytes that only extend the sign.
+	n := b[:]
+	for len(n) > 1 && (n[0] == 0 && n[1]&0x80 == 0 || n[0] == 0xff && n[1]&0x80 != 0) {
+		n = n[1:]
+	}
+	if size == 0 {
+		return append(buf, n...), nil
+	}
+	if len(n) > size {
+		return buf, fmt.Errorf("%v at scale %d overflows %d bytes: %w", d, scale, size, ErrRange)
+	}
+	var ext byte
+	if hi < 0 {
+		ext = 0xff
+	}
+	for i := len(n); i < size; i++ {
+		buf = append(buf, ext)
+	}
+	return append(buf, n...), nil
+}
+
+// unscaled returns the sign and magnitude of d × 10^scale rounded to an
+// integer with ctx.
+func (ctx Context) unscaled(d Decimal, scale int) (neg bool, u uint128T, err error) {
+	if !d.isFinite() {
+		return false, u, fmt.Errorf("%v has no unscaled representation", d)
+	}
+	var dp decParts
+	dp.unpack(d)
+	neg = dp.sign == 1
+	sig := dp.significand.lo
+	if sig == 0 {
+		return neg, u, nil
+	}
+
+	shift := int(dp.exp) + scale
+	if shift >= 0 {
+		if numDecimalDigitsU64(sig)+shift > maxUnscaledDigits {
+			return neg, u, fmt.Errorf("%v at scale %d has more than %d digits: %w",
+				d, scale, maxUnscaledDigits, ErrRange)
+		}
+		u.mul64(&tenToThe128[shift], sig)
+		return neg, u, nil
+	}
+
+	var q uint64
+	var rndStatus discardedDigit
+	if k := -shift; k > 19 {
+		rndStatus = lt5
+	} else {
+		q = sig / tenToThe[k]
+		rndStatus = roundStatus(sig, int16(k))
+	}
+	u.lo = ctx.Rounding.round(q, rndStatus)
+	return neg, u, nil
+}
+
+// NewFromUnscaled128 returns the signed 128-bit integer with high and low
+// 64 bits hi and lo, divided by 10^scale.
+// It uses [DefaultScanContext].
+func NewFromUnscaled128(hi int64, lo uint64, scale int) (Decimal, error) {
+	return DefaultScanContext.NewFromUnscaled128(hi, lo, scale)
+}
+
+// NewFromUnscaled64 returns u divided by 10^scale.
+// It uses [DefaultScanContext].
+func NewFromUnscaled64(u int64, scale int) (Decimal, error) {
+	return DefaultScanContext.NewFromUnscaled64(u, scale)
+}
+
+// DecodeUnscaled decodes a big-endian two's complement integer and divides
+// it by 10^scale.
+// It uses [DefaultScanContext].
+func DecodeUnscaled(b []byte, scale int) (Decimal, error) {
+	return DefaultScanContext.DecodeUnscaled(b, scale)
+}
+
+// NewFromUnscaled128 returns the signed 128-bit integer with high and low
+// 64 bits hi and lo, divided by 10^scale.
+//
+// Values with more than 16 significant digits are rounded according to ctx
+// and reported with [ErrInexact]. Values too large for a [Decimal] become ±∞
+// and are reported with [ErrRange]. In both cases the converted value is
+// still returned.
+func (ctx Context) NewFromUnscaled128(hi int64, lo uint64, scale int) (Decimal, error) {
+	u := uint128T{lo: lo, hi: uint64(hi)}
+	var sign int8
+	if hi < 0 {
+		sign = 1
+		u.sub(&uint128T{}, &u)
+	}
+	return ctx.newFromCoefficient(sign, -scale, u, false)
+}
+
+// NewFromUnscaled64 returns u divided by 10^scale.
+// See [Context.NewFromUnscaled128] for how values are rounded.
+func (ctx Context) NewFromUnscaled64(u int64, scale int) (Decimal, error) {
+	hi := int64(0)
+	if u < 0 {
+		hi = -1
+	}
+	return ctx.NewFromUnscaled128(hi, uint64(u), scale)
+}
+
+// DecodeUnscaled decodes a big-endian two's complement integer of any
+// length and divides it by 10^scale.
+// See [Context.NewFromUnscaled128] for how values are rounded.
+func (ctx Context) DecodeUnscaled(b []byte, scale int) (Decimal, error) {
+	if len(b) == 0 {
+		return QNaN, fmt.Errorf("empty unscaled integer")
+	}
+	neg := b[0]&0x80 != 0
+	for len(b) > 1 && (b[0] == 0 && b[1]&0x80 == 0 || b[0] == 0xff && b[1]&0x80 != 0) {
+		b = b[1:]
+	}
+	if len(b) <= 16 {
+		var ext byte
+		if neg {
+			ext = 0xff
+		}
+		var buf [16]byte
+		for i := range buf[:16-len(b)] {
+			buf[i] = ext
+		}
+		copy(buf[16-len(b):], b)
+		hi := binary.BigEndian.Uint64(buf[:8])
+		lo := binary.BigEndian.Uint64(buf[8:])
+		return ctx.NewFromUnscaled128(int64(hi), lo, scale)
+	}
+
+	i := new(big.Int).SetBytes(b)
+	if neg {
+		i.Sub(i, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+	}
+	return ctx.newFromBigInt(i, -scale)
+}
+
+// newFromBigInt returns i × 10^exp, rounded with ctx as for
+// [Context.newFromCoefficient].
+func (ctx Context) newFromBigInt(i *big.Int, exp int) (Decimal, error) {
+	var sign int8
+	if i.Sign() < 0 {
+		sign = 1
+	}
+	// Keep the leading 38 digits, which fit in 128 bits, and remember
+	// whether any of the rest were nonzero.
+	digits := new(big.Int).Abs(i).Text(10)
+	rest := ""
+	if len(digits) > maxUnscaledDigits {
+		digits, rest = digits[:maxUnscaledDigits], digits[maxUnscaledDigits:]
+	}
+	var c uint128T
+	for _, r := range digits {
+		c.mul64(&c, 10)
+		c.add(&c, &uint128T{lo: uint64(r - '0')})
+	}
+	sticky := strings.TrimRight(rest, "0") != ""
+	return ctx.newFromCoefficient(sign, exp+len(rest), c, sticky)
+}
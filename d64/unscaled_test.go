@@ -0,0 +1,133 @@
+package d64
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestDecimalUnscaled(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected int64, s string, scale int) {
+		t.Helper()
+		d := MustParse(s)
+		i, err := d.Unscaled64(scale)
+		isnil(t, err)
+		equal(t, expected, i)
+
+		hi, lo, err := d.Unscaled128(scale)
+		isnil(t, err)
+		equal(t, expected>>63, hi)
+		equal(t, uint64(expected), lo)
+	}
+
+	test(1234, "12.34", 2)
+	test(-1234, "-12.34", 2)
+	test(123400, "12.34", 4)
+	test(0, "-0", 2)
+	test(13, "12.5", 0)
+	test(-13, "-12.5", 0)
+	test(12, "12.49", 0)
+	test(0, "0.4", 0)
+	test(0, "1e-30", 2)
+	test(12, "1234", -2)
+	test(9223372036854775000, "9223372036854775e3", 0)
+	test(-9223372036854775000, "-9223372036854775e3", 0)
+
+	i, err := Context{Rounding: HalfEven}.Unscaled64(MustParse("12.5"), 0)
+	isnil(t, err)
+	equal(t, int64(12), i)
+
+	_, err = MustParse("9223372036854776e3").Unscaled64(0)
+	check(t, errors.Is(err, ErrRange))
+	_, err = MustParse("1e20").Unscaled64(0)
+	check(t, errors.Is(err, ErrRange))
+	_, err = QNaN.Unscaled64(0)
+	notnil(t, err)
+
+	j, err := MustParse("-21474836.48").Unscaled32(2)
+	isnil(t, err)
+	equal(t, int32(math.MinInt32), j)
+	_, err = MustParse("21474836.48").Unscaled32(2)
+	check(t, errors.Is(err, ErrRange))
+
+	hi, lo, err := MustParse("-1e37").Unscaled128(0)
+	isnil(t, err)
+	equal(t, int64(-542101086242752218), hi)
+	equal(t, uint64(18378004118569484288), lo)
+	_, _, err = MustParse("1e37").Unscaled128(2)
+	check(t, errors.Is(err, ErrRange))
+	_, _, err = Inf.Unscaled128(2)
+	notnil(t, err)
+}
+
+func TestDecimalAppendUnscaled(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, s string, scale, size int) {
+		t.Helper()
+		d := MustParse(s)
+		buf, err := d.AppendUnscaled(nil, scale, size)
+		isnil(t, err)
+		equal(t, expected, fmt.Sprintf("%x", buf))
+
+		e, err := DecodeUnscaled(buf, scale)
+		isnil(t, err)
+		check(t, d.Equal(e))
+	}
+
+	test("00", "0", 2, 0)
+	test("04d2", "12.34", 2, 0)
+	test("fb2e", "-12.34", 2, 0)
+	test("7f", "1.27", 2, 0)
+	test("0080", "1.28", 2, 0)
+	test("80", "-1.28", 2, 0)
+	test("ff7f", "-1.29", 2, 0)
+	test("000004d2", "12.34", 2, 4)
+	test("fffffb2e", "-12.34", 2, 4)
+	test("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "-0.01", 2, 30)
+	test("0785ee10d5da46d900f436a000000000", "1e37", 0, 0)
+
+	_, err := MustParse("1.28").AppendUnscaled(nil, 2, 1)
+	check(t, errors.Is(err, ErrRange))
+	_, err = One.AppendUnscaled(nil, 2, -1)
+	notnil(t, err)
+}
+
+func TestDecodeUnscaled(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, expectedErr error, b []byte, scale int) {
+		t.Helper()
+		d, err := DecodeUnscaled(b, scale)
+		check(t, errors.Is(err, expectedErr))
+		equal(t, expected, d.String())
+	}
+
+	test("12.34", nil, []byte{0, 0, 0, 0, 0x04, 0xd2}, 2)
+	test("-12.34", nil, []byte{0xff, 0xff, 0xfb, 0x2e}, 2)
+	test("-1.701411834604692e+36", ErrInexact, []byte{0x80, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 2)
+
+	// 2¹⁵⁹ and -2¹⁵⁹ need more than 128 bits.
+	big := make([]byte, 21)
+	big[1] = 0x80
+	test("7.307508186654515e+47", ErrInexact, big, 0)
+	big[0], big[1] = 0xff, 0x80
+	test("-7.307508186654515e+47", ErrInexact, big, 0)
+	// 10⁴⁰ is exact.
+	test("1e+40", nil, []byte{0x1d, 0x63, 0x29, 0xf1, 0xc3, 0x5c, 0xa4, 0xbf, 0xab, 0xb9, 0xf5, 0x61, 0x00, 0x00, 0x00, 0x00, 0x00}, 0)
+
+	test("inf", ErrRange, []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, -400)
+
+	_, err := DecodeUnscaled(nil, 0)
+	notnil(t, err)
+
+	d, err := NewFromUnscaled64(-5, 1)
+	isnil(t, err)
+	equalD64(t, MustParse("-0.5"), d)
+	d, err = NewFromUnscaled128(0, 12345678901234567, 0)
+	check(t, errors.Is(err, ErrInexact))
+	equalD64(t, MustParse("12345678901234570"), d)
+}
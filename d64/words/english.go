@@ -0,0 +1,123 @@
+package words
+
+import "strings"
+
+// English spells out numbers in English with the short scale, in which a
+// billion is a thousand million. By default, it puts "and" before the tens
+// and units, as in "one hundred and five", as is usual in Commonwealth
+// countries.
+type English struct {
+	// OmitAnd omits "and" within numbers, as in "one hundred five", as is
+	// usual in the United States. It doesn't affect the "and" between major
+	// and minor units.
+	OmitAnd bool
+}
+
+var englishUnits = [...]string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+	"seventeen", "eighteen", "nineteen",
+}
+
+var englishTens = [...]string{
+	2: "twenty", 3: "thirty", 4: "forty", 5: "fifty",
+	6: "sixty", 7: "seventy", 8: "eighty", 9: "ninety",
+}
+
+// englishScales names powers of a thousand.
+var englishScales = [...]string{
+	"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+}
+
+// Cardinal returns the words for n, e.g., "one thousand two hundred and
+// thirty-four".
+func (e English) Cardinal(n uint64) string {
+	if n == 0 {
+		return englishUnits[0]
+	}
+
+	// Groups of three digits, least significant first.
+	var groups [len(englishScales)]uint64
+	top := 0
+	for i := 0; n > 0; i++ {
+		groups[i] = n % 1000
+		n /= 1000
+		top = i
+	}
+
+	var b strings.Builder
+	for i := top; i >= 0; i-- {
+		g := groups[i]
+		if g == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+			// A final group without hundreds follows "and", as in "one
+			// thousand and five".
+			if i == 0 && g < 100 && !e.OmitAnd {
+				b.WriteString("and ")
+			}
+		}
+		e.group(&b, g)
+		if i > 0 {
+			b.WriteByte(' ')
+			b.WriteString(englishScales[i])
+		}
+	}
+	return b.String()
+}
+
+// group writes the words for 0 < g < 1000.
+func (e English) group(b *strings.Builder, g uint64) {
+	if h := g / 100; h > 0 {
+		b.WriteString(englishUnits[h])
+		b.WriteString(" hundred")
+		if g%100 == 0 {
+			return
+		}
+		b.WriteByte(' ')
+		if !e.OmitAnd {
+			b.WriteString("and ")
+		}
+	}
+	switch g %= 100; {
+	case g < 20:
+		b.WriteString(englishUnits[g])
+	default:
+		b.WriteString(englishTens[g/10])
+		if g%10 != 0 {
+			b.WriteByte('-')
+			b.WriteString(englishUnits[g%10])
+		}
+	}
+}
+
+// Amount returns the words for a, e.g., "twelve dollars and five cents". It
+// omits zero minor units, and zero major units if there are minor units.
+func (e English) Amount(a Amount) string {
+	var parts []string
+	if a.Major != 0 || a.Minor == 0 {
+		parts = append(parts, e.phrase(a.Major, a.MajorUnit))
+	}
+	if a.Minor != 0 {
+		parts = append(parts, e.phrase(a.Minor, a.MinorUnit))
+	}
+	text := strings.Join(parts, " and ")
+	if a.Negative {
+		text = "minus " + text
+	}
+	return text
+}
+
+// phrase returns the words for n units of u.
+func (e English) phrase(n uint64, u Unit) string {
+	name := u.Singular
+	if n != 1 && u.Plural != "" {
+		name = u.Plural
+	}
+	if name == "" {
+		return e.Cardinal(n)
+	}
+	return e.Cardinal(n) + " " + name
+}
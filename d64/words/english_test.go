@@ -0,0 +1,70 @@
+package words
+
+import (
+	"testing"
+
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func TestEnglishCardinal(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, n uint64) {
+		t.Helper()
+		expect.Equal(t, expected, English{}.Cardinal(n))
+	}
+	test("zero", 0)
+	test("seven", 7)
+	test("thirteen", 13)
+	test("twenty", 20)
+	test("forty-two", 42)
+	test("ninety-nine", 99)
+	test("one hundred", 100)
+	test("one hundred and five", 105)
+	test("three hundred and sixty-five", 365)
+	test("one thousand", 1000)
+	test("one thousand and five", 1005)
+	test("one thousand and ninety", 1090)
+	test("one thousand one hundred", 1100)
+	test("one thousand two hundred and thirty-four", 1234)
+	test("twelve thousand three hundred and forty-five", 12345)
+	test("one million", 1_000_000)
+	test("one million and one", 1_000_001)
+	test("two million three hundred thousand", 2_300_000)
+	test("one billion two hundred and thirty-four million five hundred and sixty-seven thousand eight hundred and ninety", 1_234_567_890)
+	test("eighteen quintillion four hundred and forty-six quadrillion seven hundred and forty-four trillion "+
+		"seventy-three billion seven hundred and nine million five hundred and fifty-one thousand six hundred and fifteen",
+		1<<64-1)
+}
+
+func TestEnglishCardinalOmitAnd(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, n uint64) {
+		t.Helper()
+		expect.Equal(t, expected, English{OmitAnd: true}.Cardinal(n))
+	}
+	test("one hundred five", 105)
+	test("one thousand five", 1005)
+	test("one thousand two hundred thirty-four", 1234)
+}
+
+func TestEnglishAmount(t *testing.T) {
+	t.Parallel()
+
+	dollar, cent := Unit{"dollar", "dollars"}, Unit{"cent", "cents"}
+	test := func(expected string, a Amount) {
+		t.Helper()
+		a.MajorUnit, a.MinorUnit = dollar, cent
+		expect.Equal(t, expected, English{}.Amount(a))
+	}
+	test("zero dollars", Amount{})
+	test("one dollar", Amount{Major: 1})
+	test("one cent", Amount{Minor: 1})
+	test("twelve dollars and five cents", Amount{Major: 12, Minor: 5})
+	test("one dollar and one cent", Amount{Major: 1, Minor: 1})
+	test("minus two dollars", Amount{Negative: true, Major: 2})
+
+	expect.Equal(t, "five yen", English{}.Amount(Amount{Major: 5, MajorUnit: Unit{Singular: "yen"}}))
+	expect.Equal(t, "five", English{}.Amount(Amount{Major: 5}))
+}
@@ -0,0 +1,151 @@
+// Package words writes [d64.Decimal] amounts out in words, as on cheques and
+// in legal documents, e.g., "One thousand two hundred and thirty-four dollars
+// and fifty-six cents".
+//
+// A [Speller] splits an amount into major and minor units, such as dollars
+// and cents, and a [Language] puts them into words. [English] is provided;
+// other languages implement the [Language] interface.
+package words
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/anz-bank/decimal/d64"
+)
+
+// MaxMinorDigits is the largest number of decimal places a [Speller] may
+// give minor units.
+const MaxMinorDigits = 18
+
+var (
+	// ErrNotFinite is reported when NaN or ±∞ is spelled out.
+	ErrNotFinite = errors.New("words: value not finite")
+
+	// ErrRange is reported when the whole number of major units doesn't
+	// fit in a uint64.
+	ErrRange = errors.New("words: value too large")
+)
+
+// Unit names a unit of currency or measure.
+type Unit struct {
+	Singular string // Name of one unit, e.g., "dollar"
+	Plural   string // Name of other quantities, e.g., "dollars"; if empty, Singular is used
+}
+
+// Amount is an amount split into whole major and minor units, ready for a
+// [Language] to spell out.
+type Amount struct {
+	Negative  bool
+	Major     uint64 // Whole major units
+	Minor     uint64 // Whole minor units, less than one major unit
+	MajorUnit Unit
+	MinorUnit Unit
+}
+
+// Language spells out amounts in a natural language.
+type Language interface {
+	// Cardinal returns the words for n, e.g., "one thousand and five".
+	Cardinal(n uint64) string
+
+	// Amount returns the words for a, e.g., "minus twelve dollars and five
+	// cents". If a has no minor units, it may omit them.
+	Amount(a Amount) string
+}
+
+// Speller spells out amounts. The zero value spells out integers in
+// English without units.
+type Speller struct {
+	// Language puts amounts into words. If it is nil, English{} is used.
+	Language Language
+
+	// Major and Minor name the units, e.g., dollars and cents.
+	Major, Minor Unit
+
+	// MinorDigits is the number of decimal places that minor units
+	// represent, e.g., 2 for cents, which are hundredths of a dollar. It may
+	// be zero for currencies without minor units and may not exceed
+	// MaxMinorDigits.
+	MinorDigits int
+
+	// Capitalize makes the first letter upper case.
+	Capitalize bool
+}
+
+// Dollars spells out amounts of dollars and cents in English.
+var Dollars = Speller{
+	Major:       Unit{"dollar", "dollars"},
+	Minor:       Unit{"cent", "cents"},
+	MinorDigits: 2,
+	Capitalize:  true,
+}
+
+// Spell returns the words for d. It reports [ErrNotFinite] if d is NaN or
+// ±∞, an error wrapping [d64.ErrInexact] if d has more decimal places than
+// s.MinorDigits and [ErrRange] if d is too large.
+func (s Speller) Spell(d d64.Decimal) (string, error) {
+	a, err := s.split(d)
+	if err != nil {
+		return "", err
+	}
+	lang := s.Language
+	if lang == nil {
+		lang = English{}
+	}
+	text := lang.Amount(a)
+	if s.Capitalize {
+		if r, size := utf8.DecodeRuneInString(text); r != utf8.RuneError {
+			text = string(unicode.ToUpper(r)) + text[size:]
+		}
+	}
+	return text, nil
+}
+
+// split splits d into major and minor units.
+func (s Speller) split(d d64.Decimal) (Amount, error) {
+	if s.MinorDigits < 0 || s.MinorDigits > MaxMinorDigits {
+		return Amount{}, fmt.Errorf("words: MinorDigits %d out of range", s.MinorDigits)
+	}
+	var buf [8]byte
+	form, neg, coefficient, exp := d.Decompose(buf[:0])
+	if form != d64.FormFinite {
+		return Amount{}, fmt.Errorf("%w: %v", ErrNotFinite, d)
+	}
+	var c uint64
+	for _, b := range coefficient {
+		c = c<<8 | uint64(b)
+	}
+
+	a := Amount{Negative: neg && c != 0, MajorUnit: s.Major, MinorUnit: s.Minor}
+	switch {
+	case exp >= 0:
+		for ; exp > 0; exp-- {
+			hi, lo := bits.Mul64(c, 10)
+			if hi != 0 {
+				return Amount{}, fmt.Errorf("%w: %v", ErrRange, d)
+			}
+			c = lo
+		}
+		a.Major = c
+	case int(-exp) > s.MinorDigits:
+		// Decompose removes trailing zeros, so the last digit isn't zero.
+		return Amount{}, fmt.Errorf("words: %v has more than %d decimal places: %w", d, s.MinorDigits, d64.ErrInexact)
+	default:
+		unit := pow10(int(-exp))
+		a.Major = c / unit
+		a.Minor = c % unit * pow10(s.MinorDigits+int(exp))
+	}
+	return a, nil
+}
+
+// pow10 returns 10^n for 0 ≤ n ≤ 19.
+func pow10(n int) uint64 {
+	p := uint64(1)
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
+}
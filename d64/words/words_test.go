@@ -0,0 +1,86 @@
+package words
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/anz-bank/decimal/d64"
+	"github.com/anz-bank/decimal/d64/internal/expect"
+)
+
+func TestSpell(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected string, s Speller, d string) {
+		t.Helper()
+		text, err := s.Spell(d64.MustParse(d))
+		expect.Nil(t, err)
+		expect.Equal(t, expected, text)
+	}
+	test("One thousand two hundred and thirty-four dollars and fifty-six cents", Dollars, "1234.56")
+	test("Five cents", Dollars, "0.05")
+	test("Fifty cents", Dollars, "0.5")
+	test("One dollar", Dollars, "1.00")
+	test("Zero dollars", Dollars, "0")
+	test("Zero dollars", Dollars, "-0")
+	test("Minus ten dollars and one cent", Dollars, "-10.01")
+	test("One hundred million dollars", Dollars, "1e8")
+	test("Eighteen quintillion dollars", Dollars, "1.8e19")
+
+	test("forty-two", Speller{}, "42")
+	test("one thousand five", Speller{Language: English{OmitAnd: true}}, "1005")
+	yen := Speller{Major: Unit{Singular: "yen"}}
+	test("one hundred yen", yen, "100")
+	dinar := Speller{Major: Unit{"dinar", "dinars"}, Minor: Unit{"fils", "fils"}, MinorDigits: 3}
+	test("two dinars and five hundred fils", dinar, "2.5")
+	test("two dinars and five fils", dinar, "2.005")
+}
+
+func TestSpellErrors(t *testing.T) {
+	t.Parallel()
+
+	test := func(target error, s Speller, d d64.Decimal) {
+		t.Helper()
+		text, err := s.Spell(d)
+		expect.ErrorIs(t, err, target)
+		expect.Equal(t, "", text)
+	}
+	test(ErrNotFinite, Dollars, d64.QNaN)
+	test(ErrNotFinite, Dollars, d64.NegInf)
+	test(d64.ErrInexact, Dollars, d64.MustParse("1.005"))
+	test(d64.ErrInexact, Dollars, d64.MustParse("1e-300"))
+	test(d64.ErrInexact, Speller{}, d64.MustParse("0.5"))
+	test(ErrRange, Dollars, d64.MustParse("1.9e19"))
+	test(ErrRange, Dollars, d64.Max)
+
+	_, err := Speller{MinorDigits: 19}.Spell(d64.One)
+	if err == nil {
+		t.Error("expected error for MinorDigits 19")
+	}
+}
+
+// pigLatin is a toy language that shows how to plug in another language.
+type pigLatin struct{}
+
+func (pigLatin) Cardinal(n uint64) string {
+	words := strings.Fields(English{OmitAnd: true}.Cardinal(n))
+	for i, w := range words {
+		words[i] = w[1:] + w[:1] + "ay"
+	}
+	return strings.Join(words, " ")
+}
+
+func (p pigLatin) Amount(a Amount) string {
+	return fmt.Sprintf("%s %s, %s %s", p.Cardinal(a.Major), a.MajorUnit.Plural, p.Cardinal(a.Minor), a.MinorUnit.Plural)
+}
+
+func TestSpellLanguage(t *testing.T) {
+	t.Parallel()
+
+	s := Dollars
+	s.Language = pigLatin{}
+	text, err := s.Spell(d64.MustParse("12.03"))
+	expect.Nil(t, err)
+	expect.Equal(t, "Welvetay dollars, hreetay cents", text)
+}
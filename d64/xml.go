@@ -0,0 +1,150 @@
+package d64
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+var _ xml.Marshaler = Zero
+var _ xml.Unmarshaler = (*Decimal)(nil)
+var _ xml.MarshalerAttr = Zero
+var _ xml.UnmarshalerAttr = (*Decimal)(nil)
+
+// MarshalXML implements the xml.Marshaler interface.
+// The element content is in the xs:decimal lexical space, which has no
+// exponent notation and no representation for NaN or ±∞.
+func (d Decimal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	text, err := appendXSDecimal(nil, d)
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(string(text), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// The element content must be an exactly representable xs:decimal.
+func (d *Decimal) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	e, err := parseXSDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = e
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// The attribute value is in the xs:decimal lexical space.
+func (d Decimal) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	text, err := appendXSDecimal(nil, d)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// The attribute value must be an exactly representable xs:decimal.
+func (d *Decimal) UnmarshalXMLAttr(attr xml.Attr) error {
+	e, err := parseXSDecimal(attr.Value)
+	if err != nil {
+		return err
+	}
+	*d = e
+	return nil
+}
+
+// XSDecimal is a [Decimal] that also uses the xs:decimal lexical space as
+// text, for XML character data. A Decimal field tagged `xml:",chardata"` is
+// encoded with [Decimal.MarshalText] rather than [Decimal.MarshalXML], and
+// so may use exponent notation, NaN or ±∞; an XSDecimal field is encoded and
+// validated like an element or attribute.
+//
+//	type InstdAmt struct {
+//		Ccy    string        `xml:"Ccy,attr"`
+//		Amount d64.XSDecimal `xml:",chardata"`
+//	}
+type XSDecimal struct {
+	Decimal
+}
+
+var _ encoding.TextMarshaler = XSDecimal{}
+var _ encoding.TextUnmarshaler = (*XSDecimal)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The text is in the xs:decimal lexical space.
+func (x XSDecimal) MarshalText() ([]byte, error) {
+	return appendXSDecimal(nil, x.Decimal)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text must be an exactly representable xs:decimal.
+func (x *XSDecimal) UnmarshalText(text []byte) error {
+	d, err := parseXSDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	x.Decimal = d
+	return nil
+}
+
+func appendXSDecimal(buf []byte, d Decimal) ([]byte, error) {
+	if !d.isFinite() {
+		return buf, fmt.Errorf("%v is not representable as xs:decimal", d)
+	}
+	return d.Append(buf, 'f', -1), nil
+}
+
+// parseXSDecimal parses s, which must match the xs:decimal lexical space
+// (\+|-)?([0-9]+(\.[0-9]*)?|\.[0-9]+) after whitespace collapsing.
+// It reports an error if s can't be represented exactly.
+func parseXSDecimal(s string) (Decimal, error) {
+	t := strings.Trim(s, " \t\r\n")
+	body := t
+	if len(body) > 0 && (body[0] == '+' || body[0] == '-') {
+		body = body[1:]
+	}
+
+	point := strings.IndexByte(body, '.')
+	if point < 0 {
+		point = len(body)
+	}
+
+	// Track the powers of ten of the first and last nonzero digits.
+	digits, hi, lo := 0, 0, 0
+	nonzero := false
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		if i == point {
+			continue
+		}
+		if !isDigit(rune(c)) {
+			return QNaN, fmt.Errorf("invalid xs:decimal %q", s)
+		}
+		digits++
+		if c != '0' {
+			power := point - i - 1
+			if i > point {
+				power++
+			}
+			if !nonzero {
+				hi, nonzero = power, true
+			}
+			lo = power
+		}
+	}
+	switch {
+	case digits == 0:
+		return QNaN, fmt.Errorf("invalid xs:decimal %q", s)
+	case hi-lo >= decimalDigits, lo < -expOffset:
+		return QNaN, fmt.Errorf("xs:decimal %q: %w", s, ErrInexact)
+	case hi > expMax+decimalDigits-1:
+		return QNaN, fmt.Errorf("xs:decimal %q: %w", s, ErrRange)
+	}
+	return DefaultContext.Parse(t)
+}
@@ -0,0 +1,118 @@
+package d64
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type instdAmt struct {
+	XMLName xml.Name  `xml:"InstdAmt"`
+	Ccy     string    `xml:"Ccy,attr"`
+	Amount  XSDecimal `xml:",chardata"`
+}
+
+type pmtInf struct {
+	XMLName xml.Name `xml:"PmtInf"`
+	CtrlSum Decimal  `xml:"CtrlSum"`
+	Rate    Decimal  `xml:"Rate,attr"`
+}
+
+func TestDecimalMarshalXML(t *testing.T) {
+	t.Parallel()
+
+	x, err := xml.Marshal(pmtInf{CtrlSum: MustParse("1.5e6"), Rate: MustParse("-0.000012")})
+	isnil(t, err)
+	equal(t, `<PmtInf Rate="-0.000012"><CtrlSum>1500000</CtrlSum></PmtInf>`, string(x))
+
+	_, err = xml.Marshal(pmtInf{CtrlSum: Inf})
+	notnil(t, err)
+	_, err = xml.Marshal(pmtInf{Rate: QNaN})
+	notnil(t, err)
+}
+
+func TestDecimalUnmarshalXML(t *testing.T) {
+	t.Parallel()
+
+	var p pmtInf
+	isnil(t, xml.Unmarshal([]byte(`<PmtInf Rate=" +.5 "><CtrlSum>
+		0012.50
+	</CtrlSum></PmtInf>`), &p))
+	equalD64(t, MustParse("12.5"), p.CtrlSum)
+	equalD64(t, MustParse("0.5"), p.Rate)
+
+	x, err := xml.Marshal(p)
+	isnil(t, err)
+	equal(t, `<PmtInf Rate="0.5"><CtrlSum>12.5</CtrlSum></PmtInf>`, string(x))
+
+	var a instdAmt
+	isnil(t, xml.Unmarshal([]byte(`<InstdAmt Ccy="AUD">12.50</InstdAmt>`), &a))
+	equal(t, "AUD", a.Ccy)
+	equalD64(t, MustParse("12.5"), a.Amount.Decimal)
+
+	for _, s := range []string{"1e5", "NaN", "inf", "12,50", ""} {
+		notnil(t, xml.Unmarshal([]byte(`<InstdAmt Ccy="AUD">`+s+`</InstdAmt>`), &a)).Or(func() {
+			t.Errorf("accepted %q", s)
+		})
+	}
+}
+
+func TestXSDecimalMarshalXML(t *testing.T) {
+	t.Parallel()
+
+	test := func(expected, amount string) {
+		t.Helper()
+		x, err := xml.Marshal(instdAmt{Ccy: "AUD", Amount: XSDecimal{MustParse(amount)}})
+		isnil(t, err)
+		equal(t, `<InstdAmt Ccy="AUD">`+expected+`</InstdAmt>`, string(x))
+	}
+	test("12.5", "12.50")
+	test("150000000000000000000", "1.5e20")
+	test("0.0000000000000000012", "1.2e-18")
+	test("-0.000001", "-1e-6")
+
+	for _, d := range []Decimal{Inf, NegInf, QNaN} {
+		_, err := xml.Marshal(instdAmt{Ccy: "AUD", Amount: XSDecimal{d}})
+		notnil(t, err)
+	}
+}
+
+func TestParseXSDecimal(t *testing.T) {
+	t.Parallel()
+
+	valid := func(expected, s string) {
+		t.Helper()
+		d, err := parseXSDecimal(s)
+		isnil(t, err)
+		equalD64(t, MustParse(expected), d)
+	}
+	invalid := func(s string, target error) {
+		t.Helper()
+		_, err := parseXSDecimal(s)
+		notnil(t, err)
+		if target != nil {
+			check(t, errors.Is(err, target))
+		}
+	}
+
+	valid("0", "0")
+	valid("-0", "-0.")
+	valid("1.25", "1.250000000000000000000")
+	valid("123", "+123")
+	valid("0.001", ".001")
+	valid("1234567890123456", "1234567890123456")
+	valid("1e-398", "0."+strings.Repeat("0", 397)+"1")
+
+	invalid("", nil)
+	invalid(".", nil)
+	invalid("-", nil)
+	invalid("1e5", nil)
+	invalid("1.2.3", nil)
+	invalid("NaN", nil)
+	invalid("INF", nil)
+	invalid("1 000", nil)
+	invalid("12345678901234567", ErrInexact)
+	invalid("0."+strings.Repeat("0", 398)+"1", ErrInexact)
+	invalid("1"+strings.Repeat("0", 385), ErrRange)
+}